@@ -0,0 +1,239 @@
+/*
+Package backup snapshots the patches of a whole amp into a single archive
+that can be restored onto the same or another amp.
+System settings aren't part of archives, as their addresses aren't known.
+
+Archives are zip files with a manifest.json and a binary patch file per slot.
+*/
+package backup
+
+import (
+	"archive/zip"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+//Version of the archive format written by this package.
+//Version 1 archives hashed the padded patch bytes, their hashes aren't checked.
+const Version = 2
+
+const manifestFile = "manifest.json"
+
+var (
+	ErrManifest = errors.New("Backup archive has no manifest")
+	ErrVersion  = errors.New("Backup archive version is not supported")
+	ErrHash     = errors.New("Backup archive patch doesn't match its hash")
+)
+
+//A file the manifest lists, but the archive doesn't have.
+type MissingError string
+
+func (e MissingError) Error() string {
+	return fmt.Sprintf("Backup archive is missing %s", string(e))
+}
+
+//Slots in the order they're backed up and restored.
+var Slots = []Slot{
+	{Name: "ch1", Region: sysex.CH1Region},
+	{Name: "ch2", Region: sysex.CH2Region},
+	{Name: "ch3", Region: sysex.CH3Region},
+	{Name: "ch4", Region: sysex.CH4Region},
+	{Name: "panel", Region: sysex.PanelRegion},
+}
+
+//A patch region in an archive.
+type Slot struct {
+	Name   string        `json:"name"`
+	Region libktn.Uint14 `json:"region"`
+	File   string        `json:"file,omitempty"`
+	//Hex SHA-256 of the patch parameters, see patch.Hash.
+	Hash string `json:"sha256,omitempty"`
+}
+
+//Describes the contents of an archive.
+type Manifest struct {
+	Version    int              `json:"version"`
+	Created    time.Time        `json:"created"`
+	Amp        string           `json:"amp"`
+	Generation model.Generation `json:"generation"`
+	Slots      []Slot           `json:"slots"`
+}
+
+//Everything backed up from an amp.
+type Archive struct {
+	Manifest Manifest
+	Patches  map[libktn.Uint14]patch.Patch
+}
+
+//Creates an empty archive for a Katana variant.
+func New(c *model.Capability) *Archive {
+	return &Archive{
+		Manifest: Manifest{Version: Version, Created: time.Now().UTC(), Amp: c.Name, Generation: c.Generation},
+		Patches:  map[libktn.Uint14]patch.Patch{},
+	}
+}
+
+func slotName(region libktn.Uint14) string {
+	for _, s := range Slots {
+		if s.Region == region {
+			return s.Name
+		}
+	}
+	return fmt.Sprintf("region%d", region)
+}
+
+//Writes an archive as a zip file.
+func Write(w io.Writer, a *Archive) error {
+	z := zip.NewWriter(w)
+
+	m := a.Manifest
+	m.Version = Version
+	m.Slots = nil
+
+	regions := make([]libktn.Uint14, 0, len(a.Patches))
+	for r := range a.Patches {
+		regions = append(regions, r)
+	}
+	sort.Slice(regions, func(i, j int) bool { return regions[i] < regions[j] })
+
+	for _, r := range regions {
+		p := a.Patches[r]
+		bm, ok := p.(encoding.BinaryMarshaler)
+		if !ok {
+			return fmt.Errorf("%s: patch can't be stored", slotName(r))
+		}
+		b, err := bm.MarshalBinary()
+		if err != nil {
+			return err
+		}
+
+		h := patch.Hash(p)
+		s := Slot{Name: slotName(r), Region: r, File: "patches/" + slotName(r) + ".bin", Hash: hex.EncodeToString(h[:])}
+		if err := writeFile(z, s.File, b); err != nil {
+			return err
+		}
+		m.Slots = append(m.Slots, s)
+	}
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile(z, manifestFile, b); err != nil {
+		return err
+	}
+	return z.Close()
+}
+
+func writeFile(z *zip.Writer, name string, b []byte) error {
+	f, err := z.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	return err
+}
+
+//Reads an archive from a zip file.
+func Read(r io.ReaderAt, size int64) (*Archive, error) {
+	z, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]*zip.File{}
+	for _, f := range z.File {
+		files[f.Name] = f
+	}
+
+	mf, ok := files[manifestFile]
+	if !ok {
+		return nil, ErrManifest
+	}
+	a := &Archive{Patches: map[libktn.Uint14]patch.Patch{}}
+	if err := readJSON(mf, &a.Manifest); err != nil {
+		return nil, err
+	}
+	if a.Manifest.Version < 1 || a.Manifest.Version > Version {
+		return nil, ErrVersion
+	}
+
+	for _, s := range a.Manifest.Slots {
+		f, ok := files[s.File]
+		if !ok {
+			return nil, MissingError(s.File)
+		}
+		b, err := readAll(f)
+		if err != nil {
+			return nil, err
+		}
+		p, err := patch.UnmarshalBinary(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", s.File, err)
+		}
+
+		h := patch.Hash(p)
+		if a.Manifest.Version > 1 && s.Hash != "" && s.Hash != hex.EncodeToString(h[:]) {
+			return nil, ErrHash
+		}
+		a.Patches[s.Region] = p
+	}
+	return a, nil
+}
+
+func readAll(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+func readJSON(f *zip.File, v interface{}) error {
+	b, err := readAll(f)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+//Writes an archive to a file.
+func WriteFile(path string, a *Archive) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Write(f, a); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+//Reads an archive from a file.
+func ReadFile(path string) (*Archive, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return Read(f, fi.Size())
+}
@@ -0,0 +1,181 @@
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stvp/assert"
+
+	"github.com/katana-dev/lib-katana/device"
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+func amp(t *testing.T, name string) (*device.Session, *device.Emulator) {
+	host, a := device.Pipe()
+	e := device.NewEmulator(a, model.KatanaV3)
+	for _, sl := range Slots {
+		p := patch.NewDense()
+		_, err := patch.SetName(p, fmt.Sprintf("%s %s", name, sl.Name))
+		assert.Nil(t, err)
+		e.SetPatch(sl.Region, p)
+	}
+	go e.Serve()
+	return device.NewSession(host, sysex.PanelRegion), e
+}
+
+func TestArchive(t *testing.T) {
+	s, _ := amp(t, "Gig")
+	defer s.Close()
+
+	var steps []string
+	a, err := Snapshot(s, func(p Progress) { steps = append(steps, p.Step) })
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"read ch1", "read ch2", "read ch3", "read ch4", "read panel", "done"}, steps)
+	assert.Equal(t, "Katana v3.x", a.Manifest.Amp)
+
+	b := &bytes.Buffer{}
+	assert.Nil(t, Write(b, a))
+	r, err := Read(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, Version, r.Manifest.Version)
+	assert.Equal(t, len(Slots), len(r.Manifest.Slots))
+	assert.Equal(t, "patches/ch1.bin", r.Manifest.Slots[0].File)
+	for _, sl := range Slots {
+		assert.True(t, patch.Equal(a.Patches[sl.Region], r.Patches[sl.Region]))
+	}
+	n, err := patch.Name(r.Patches[sysex.CH3Region])
+	assert.Nil(t, err)
+	assert.Equal(t, "Gig ch3", n)
+}
+
+func TestArchiveErrors(t *testing.T) {
+	read := func(files map[string]string) error {
+		b := &bytes.Buffer{}
+		z := zip.NewWriter(b)
+		for n, c := range files {
+			assert.Nil(t, writeFile(z, n, []byte(c)))
+		}
+		assert.Nil(t, z.Close())
+		_, err := Read(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		return err
+	}
+
+	assert.Equal(t, ErrManifest, read(map[string]string{}))
+	assert.Equal(t, MissingError("patches/ch1.bin"), read(map[string]string{
+		manifestFile: `{"version": 2, "slots": [{"name": "ch1", "region": 2049, "file": "patches/ch1.bin"}]}`,
+	}))
+	assert.Equal(t, ErrVersion, read(map[string]string{manifestFile: `{"version": 3}`}))
+}
+
+func TestArchiveHash(t *testing.T) {
+	a := New(model.KatanaV3)
+	p := patch.NewSparse()
+	_, err := patch.SetName(p, "Sparse")
+	assert.Nil(t, err)
+	a.Patches[sysex.CH1Region] = p
+
+	b := &bytes.Buffer{}
+	assert.Nil(t, Write(b, a))
+	r, err := Read(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	assert.Nil(t, err)
+
+	//The hash doesn't depend on the encoding of the patch.
+	d, _, err := patch.Convert(p, patch.EncDense)
+	assert.Nil(t, err)
+	h := patch.Hash(d)
+	assert.Equal(t, hex.EncodeToString(h[:]), r.Manifest.Slots[0].Hash)
+
+	z, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	assert.Nil(t, err)
+	bin, err := readAll(z.File[0])
+	assert.Nil(t, err)
+	read := func(version int) error {
+		m := fmt.Sprintf(`{"version": %d, "slots": [{"name": "ch1", "region": 2049, "file": "patches/ch1.bin", "sha256": "%x"}]}`, version, make([]byte, 32))
+		b := &bytes.Buffer{}
+		z := zip.NewWriter(b)
+		assert.Nil(t, writeFile(z, manifestFile, []byte(m)))
+		assert.Nil(t, writeFile(z, "patches/ch1.bin", bin))
+		assert.Nil(t, z.Close())
+		_, err := Read(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		return err
+	}
+	assert.Equal(t, ErrHash, read(2))
+	//Version 1 hashes were over the padded bytes, they aren't checked.
+	assert.Nil(t, read(1))
+}
+
+func TestRestore(t *testing.T) {
+	src, _ := amp(t, "Main")
+	defer src.Close()
+	a, err := Snapshot(src, nil)
+	assert.Nil(t, err)
+
+	dst, e := amp(t, "Spare")
+	defer dst.Close()
+
+	//A dry run lists the changes but leaves the amp alone.
+	changes, err := Restore(dst, a, RestoreOptions{DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, len(Slots), len(changes))
+	assert.Equal(t, `ch1 name: "Spare ch1" -> "Main ch1"`, changes[0].String())
+	n, err := patch.Name(e.Patch(sysex.CH1Region))
+	assert.Nil(t, err)
+	assert.Equal(t, "Spare ch1", n)
+
+	done := 0
+	changes, err = Restore(dst, a, RestoreOptions{Progress: func(p Progress) {
+		assert.True(t, p.Done >= done && p.Done <= p.Total)
+		done = p.Done
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, len(Slots), len(changes))
+
+	//Nothing is left to change afterwards.
+	changes, err = Restore(dst, a, RestoreOptions{DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(changes))
+	n, err = patch.Name(e.Patch(sysex.CH4Region))
+	assert.Nil(t, err)
+	assert.Equal(t, "Main ch4", n)
+}
+
+func TestRestoreConverted(t *testing.T) {
+	src, _ := amp(t, "Main")
+	defer src.Close()
+	a, err := Snapshot(src, nil)
+	assert.Nil(t, err)
+
+	//A parameter only the dense encoding keeps is lost on the amp.
+	var lost patch.Param
+	for _, p := range patch.SchemaOf(patch.EncDense).Supported() {
+		if sp, ok := patch.SchemaOf(patch.EncSparse).Param(p.Name); !ok || !sp.Supported {
+			lost = p
+			break
+		}
+	}
+	assert.NotEqual(t, "", lost.Name)
+	p := patch.NewDense()
+	_, err = patch.SetName(p, "Dense")
+	assert.Nil(t, err)
+	_, err = patch.Set(p, lost, 1)
+	assert.Nil(t, err)
+	a.Patches[sysex.CH1Region] = p
+
+	dst, e := amp(t, "Spare")
+	defer dst.Close()
+	changes, err := Restore(dst, a, RestoreOptions{DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "ch1 "+lost.Name+": lost, the amp doesn't support it", changes[0].String())
+
+	_, err = Restore(dst, a, RestoreOptions{})
+	assert.Nil(t, err)
+	n, err := patch.Name(e.Patch(sysex.CH1Region))
+	assert.Nil(t, err)
+	assert.Equal(t, "Dense", n)
+}
@@ -0,0 +1,127 @@
+package backup
+
+import (
+	"fmt"
+
+	"github.com/katana-dev/lib-katana/device"
+	"github.com/katana-dev/lib-katana/patch"
+)
+
+//How far a snapshot or restore is, reported before each step.
+type Progress struct {
+	//Such as "read ch1" or "write ch1".
+	Step        string
+	Done, Total int
+}
+
+//Receives progress, may be nil.
+type ProgressFunc func(p Progress)
+
+func (f ProgressFunc) report(step string, done, total int) {
+	if f != nil {
+		f(Progress{Step: step, Done: done, Total: total})
+	}
+}
+
+//A difference between an archive and an amp, such as `ch1 od_ds_drive: 10 -> 20`.
+type Change struct {
+	//Slot name.
+	Slot string
+	Diff string
+}
+
+func (c Change) String() string {
+	return c.Slot + " " + c.Diff
+}
+
+//Reads every patch slot of an amp.
+//The session's region and patch are left as they were.
+func Snapshot(s *device.Session, progress ProgressFunc) (*Archive, error) {
+	region, current := s.Region, s.Patch
+	defer func() { s.Region, s.Patch = region, current }()
+
+	c, err := s.Identify()
+	if err != nil {
+		return nil, err
+	}
+	a := New(c)
+	enc := patch.EncodingFor(c.Generation)
+
+	total := len(Slots)
+	for i, sl := range Slots {
+		progress.report("read "+sl.Name, i, total)
+		s.Region = sl.Region
+		if err := s.Load(enc); err != nil {
+			return nil, fmt.Errorf("%s: %s", sl.Name, err)
+		}
+		a.Patches[sl.Region] = s.Patch
+	}
+
+	progress.report("done", total, total)
+	return a, nil
+}
+
+//Options for Restore.
+type RestoreOptions struct {
+	//Only list the changes, without writing anything.
+	DryRun   bool
+	Progress ProgressFunc
+}
+
+//Writes the patches of an archive to an amp, listing what changed.
+//Only the slots that differ from what the amp has are written.
+//Patches of another Katana generation are converted, the parameters that are lost are listed as changes.
+func Restore(s *device.Session, a *Archive, o RestoreOptions) ([]Change, error) {
+	//Reading everything comes first.
+	read := len(Slots)
+	total := read + len(Slots)
+	cur, err := Snapshot(s, func(p Progress) {
+		o.Progress.report(p.Step, p.Done, total)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	region, current := s.Region, s.Patch
+	defer func() { s.Region, s.Patch = region, current }()
+
+	enc := patch.EncodingFor(cur.Manifest.Generation)
+	var changes []Change
+	for i, sl := range Slots {
+		o.Progress.report("write "+sl.Name, read+i, total)
+
+		want, ok := a.Patches[sl.Region]
+		if !ok {
+			continue
+		}
+		if want.Encoding() != enc {
+			var r patch.Report
+			if want, r, err = patch.Convert(want, enc); err != nil {
+				return nil, fmt.Errorf("%s: %s", sl.Name, err)
+			}
+			for _, name := range r.Lost {
+				changes = append(changes, Change{Slot: sl.Name, Diff: name + ": lost, the amp doesn't support it"})
+			}
+		}
+
+		have := cur.Patches[sl.Region]
+		diff, err := patch.Diff(have, want)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", sl.Name, err)
+		}
+		for _, d := range diff {
+			changes = append(changes, Change{Slot: sl.Name, Diff: d})
+		}
+		if o.DryRun || patch.Equal(have, want) {
+			continue
+		}
+
+		s.Region = sl.Region
+		if err := s.Upload(want); err != nil {
+			return nil, fmt.Errorf("%s: %s", sl.Name, err)
+		}
+	}
+
+	o.Progress.report("done", total, total)
+	return changes, nil
+}
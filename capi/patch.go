@@ -15,6 +15,7 @@ import (
 )
 
 //Creates an interger reference to a new Patch.
+//
 //export ktn_new_patch
 func ktn_new_patch() C.int {
 	p, _ := patch.New(patch.EncSparse)
@@ -75,4 +76,27 @@ func ktn_get_patch_fx_chain(n C.int) unsafe.Pointer {
 	return NewCByteSlice(c)
 }
 
+/**
+ * Writes a new FX chain to a patch, reordering the block IDs of a valid chain.
+ *
+ * @param int Reference number
+ * @param void* Byte array pointer with the 20 chain positions
+ * @param int Array length
+ * @return char* CString error message, or NULL on success
+ */
+//export ktn_set_patch_fx_chain
+func ktn_set_patch_fx_chain(n C.int, arr unsafe.Pointer, len C.int) *C.char {
+	b := C.GoBytes(arr, len)
+	c := make(patch.FxChain, len)
+	for i, v := range b {
+		c[i] = patch.Block(v)
+	}
+
+	p := getObj(int32(n)).(patch.Patch)
+	if err := p.SetFxChain(c); err != nil {
+		return C.CString(err.Error())
+	}
+	return nil
+}
+
 func main() {}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/device"
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+//How to reach the amp, for the commands that talk to one.
+type ampOpts struct {
+	port    string
+	emulate bool
+	record  string
+	devId   int
+}
+
+func addAmpFlags(fs *flag.FlagSet, o *ampOpts) {
+	fs.StringVar(&o.port, "port", "", "raw MIDI device, such as /dev/snd/midiC1D0")
+	fs.BoolVar(&o.emulate, "emulate", false, "use a software amp instead of a real one")
+	fs.StringVar(&o.record, "record", "", "log all MIDI traffic to a .jsonl or .bin file")
+	fs.IntVar(&o.devId, "device", -1, "device ID of the amp, when several share the MIDI port")
+}
+
+//Either a port or -emulate is needed.
+func (o *ampOpts) valid() bool {
+	return (o.port == "") == o.emulate
+}
+
+//Starts a session with the amp. The closer ends it and closes everything opened for it.
+func (o *ampOpts) open(region libktn.Uint14) (*device.Session, func(), error) {
+	if o.devId > int(sysex.DevIdMax) {
+		return nil, nil, sysex.ErrBadDeviceId
+	}
+
+	var (
+		t       device.Transport
+		err     error
+		closers []io.Closer
+	)
+	closeAll := func() {
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i].Close()
+		}
+	}
+
+	if o.emulate {
+		host, amp := device.Pipe()
+		e := device.NewEmulator(amp, model.KatanaV3)
+		if o.devId >= 0 {
+			e.DeviceId = byte(o.devId)
+		}
+		go e.Serve()
+		t = host
+	} else if t, err = device.OpenPort(o.port); err != nil {
+		return nil, nil, err
+	}
+
+	if o.record != "" {
+		f, err := os.Create(o.record)
+		if err != nil {
+			t.Close()
+			return nil, nil, err
+		}
+		closers = append(closers, f)
+		t = device.NewRecorder(t, newLogWriter(o.record, f))
+	}
+	closers = append(closers, t)
+
+	if o.devId >= 0 {
+		router := device.NewRouter(t)
+		closers = append(closers, router)
+		if t, err = router.Device(byte(o.devId)); err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+		closers = append(closers, t)
+	}
+
+	return device.NewSession(t, region), closeAll, nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/katana-dev/lib-katana/backup"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+//Prints progress a line per step.
+func printProgress(out io.Writer) backup.ProgressFunc {
+	return func(p backup.Progress) {
+		fmt.Fprintf(out, "[%d/%d] %s\n", p.Done, p.Total, p.Step)
+	}
+}
+
+func runBackup(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("backup", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	o := ampOpts{}
+	addAmpFlags(fs, &o)
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 || !o.valid() {
+		return ErrUsage
+	}
+
+	s, closeAmp, err := o.open(sysex.PanelRegion)
+	if err != nil {
+		return err
+	}
+	defer closeAmp()
+
+	a, err := backup.Snapshot(s, printProgress(out))
+	if err != nil {
+		return err
+	}
+	return backup.WriteFile(fs.Arg(0), a)
+}
+
+func runRestore(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("restore", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	dryRun := fs.Bool("dry-run", false, "only list what would change")
+	o := ampOpts{}
+	addAmpFlags(fs, &o)
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 || !o.valid() {
+		return ErrUsage
+	}
+
+	a, err := backup.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	s, closeAmp, err := o.open(sysex.PanelRegion)
+	if err != nil {
+		return err
+	}
+	defer closeAmp()
+
+	changes, err := backup.Restore(s, a, backup.RestoreOptions{DryRun: *dryRun, Progress: printProgress(out)})
+	if err != nil {
+		return err
+	}
+	for _, c := range changes {
+		fmt.Fprintln(out, c)
+	}
+	fmt.Fprintf(out, "%d changes\n", len(changes))
+	return nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/midi"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+func newFlags(name string, o *loadOpts) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&o.region, "region", "", "patch region of .syx files")
+	fs.IntVar(&o.index, "index", 0, "patch of a .tsl liveset, starting at 0")
+	return fs
+}
+
+func runDecode(args []string, out io.Writer) error {
+	b, err := parseHex(args...)
+	if err != nil {
+		return err
+	}
+
+	//Program and control changes don't start with a sysex header.
+	if len(b) > 0 && b[0] != 0xF0 {
+		v, err := midi.Parse(b)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, v)
+		return nil
+	}
+
+	m, err := sysex.Parse(b)
+	if m == nil {
+		return err
+	}
+
+	fmt.Fprintln(out, sysex.Describe(b))
+
+	//Parse returns the message along with a checksum error.
+	return err
+}
+
+func runQuery(args []string, out io.Writer) error {
+	if len(args) != 3 {
+		return ErrUsage
+	}
+
+	a, err := parseAddress(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	s, err := parseUint14(args[2])
+	if err != nil {
+		return err
+	}
+
+	m := sysex.MakeQuery(a, libktn.Uint28(s))
+	return printSysex(out, m)
+}
+
+func runCommand(args []string, out io.Writer) error {
+	if len(args) < 3 {
+		return ErrUsage
+	}
+
+	a, err := parseAddress(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	data, err := parseHex(args[2:]...)
+	if err != nil {
+		return err
+	}
+
+	m := sysex.MakeCommand(a, data)
+	return printSysex(out, m)
+}
+
+func parseAddress(region, offset string) (sysex.Address, error) {
+	r, err := parseRegion(region)
+	if err != nil {
+		return sysex.Address{}, err
+	}
+	o, err := parseUint14(offset)
+	if err != nil {
+		return sysex.Address{}, err
+	}
+	return sysex.Address{Region: r, Offset: o}, nil
+}
+
+func printSysex(out io.Writer, m sysex.SysexMessage) error {
+	b, err := m.Sysex()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, sysex.FormatHex(b))
+	return nil
+}
+
+func runConvert(args []string, out io.Writer) error {
+	o := loadOpts{}
+	fs := newFlags("convert", &o)
+	if err := fs.Parse(args); err != nil || fs.NArg() != 2 {
+		return ErrUsage
+	}
+
+	p, err := loadPatch(fs.Arg(0), o)
+	if err != nil {
+		return err
+	}
+	return savePatch(fs.Arg(1), p, o)
+}
+
+func runDiff(args []string, out io.Writer) error {
+	o := loadOpts{}
+	fs := newFlags("diff", &o)
+	if err := fs.Parse(args); err != nil || fs.NArg() != 2 {
+		return ErrUsage
+	}
+
+	var texts [2]patch.Text
+	for i := range texts {
+		p, err := loadPatch(fs.Arg(i), o)
+		if err != nil {
+			return err
+		}
+		if texts[i], err = patch.ToText(p); err != nil {
+			return err
+		}
+	}
+
+	for _, d := range patch.DiffText(texts[0], texts[1]) {
+		fmt.Fprintln(out, d)
+	}
+	return nil
+}
+
+func runChain(args []string, out io.Writer) error {
+	o := loadOpts{}
+	fs := newFlags("chain", &o)
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return ErrUsage
+	}
+
+	p, err := loadPatch(fs.Arg(0), o)
+	if err != nil {
+		return err
+	}
+
+	for i, b := range p.GetFxChain() {
+		fmt.Fprintf(out, "%2d %s\n", i+1, patch.Block(b))
+	}
+
+	ptn, err := patch.ChainPatternOf(p)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "chain_ptn: %d\n", int(ptn))
+
+	if _, err := patch.FxChainOf(p); err != nil {
+		fmt.Fprintf(out, "invalid: %s\n", err)
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/device"
+	"github.com/katana-dev/lib-katana/patch"
+)
+
+const (
+	rowOn   = "on"
+	rowType = "type"
+	//Selects which type's parameters are shown, type values aren't mapped to names.
+	rowView = "params"
+
+	//ANSI sequences to move home and clear the screen.
+	clearScreen = "\x1b[H\x1b[2J"
+)
+
+func runEdit(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("edit", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	region := fs.String("region", "panel", "patch region to edit")
+	o := ampOpts{}
+	addAmpFlags(fs, &o)
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 || !o.valid() {
+		return ErrUsage
+	}
+
+	r, err := parseRegion(*region)
+	if err != nil {
+		return err
+	}
+
+	s, closeAmp, err := o.open(r)
+	if err != nil {
+		return err
+	}
+	defer closeAmp()
+
+	c, err := s.Identify()
+	if err != nil {
+		return err
+	}
+	if err := s.Load(patch.EncodingFor(c.Generation)); err != nil {
+		return err
+	}
+
+	restore, err := makeRaw(os.Stdin)
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	return editLoop(s, os.Stdin, out)
+}
+
+//Runs the editor until the input ends or the user quits.
+func editLoop(s *device.Session, in io.Reader, out io.Writer) error {
+	keys := make(chan key)
+	go readKeys(in, keys)
+
+	ed := &editor{s: s}
+	ed.render(out)
+	for {
+		select {
+		case k, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			quit, err := ed.key(k)
+			if err != nil || quit {
+				return err
+			}
+			ed.render(out)
+
+		case m, ok := <-s.Messages():
+			if !ok {
+				return s.Err()
+			}
+			//Panel changes show up right away.
+			if s.Handle(m) {
+				ed.render(out)
+			}
+		}
+	}
+}
+
+//Edits the effect blocks of a session's patch with the keyboard.
+type editor struct {
+	s     *device.Session
+	block int
+	row   int
+	//Index into Types of the parameters shown for each block.
+	views map[int]int
+	//Last problem to show the user, cleared on the next key.
+	status string
+}
+
+func (ed *editor) effect() *patch.Effect {
+	return patch.Effects[ed.block]
+}
+
+//Type name of the parameters shown for the current block, empty when the types share them.
+func (ed *editor) view() string {
+	types := ed.effect().Types()
+	if types == nil {
+		return ""
+	}
+	return types[ed.views[ed.block]]
+}
+
+//Lists the rows of the current block, which depend on the type being viewed.
+func (ed *editor) rows() []string {
+	rows := []string{rowOn, rowType}
+
+	e, typ := ed.effect(), ed.view()
+	if e.Types() != nil {
+		rows = append(rows, rowView)
+	}
+	names, err := e.Params(typ)
+	if err != nil {
+		return rows
+	}
+
+	for _, n := range names {
+		//Skip what the patch encoding doesn't keep.
+		if _, err := e.GetFor(ed.s.Patch, typ, n); err == nil {
+			rows = append(rows, n)
+		}
+	}
+	return rows
+}
+
+func (ed *editor) key(k key) (bool, error) {
+	ed.status = ""
+	switch k {
+	case keyQuit:
+		return true, nil
+	case keyUp:
+		if ed.row > 0 {
+			ed.row--
+		}
+	case keyDown:
+		if ed.row < len(ed.rows())-1 {
+			ed.row++
+		}
+	case keyTab, keyBackTab:
+		n := len(patch.Effects)
+		if k == keyTab {
+			ed.block = (ed.block + 1) % n
+		} else {
+			ed.block = (ed.block + n - 1) % n
+		}
+		ed.row = 0
+	case keyLeft, keyRight:
+		d := 1
+		if k == keyLeft {
+			d = -1
+		}
+		return false, ed.change(d)
+	}
+	return false, nil
+}
+
+//Steps the value of the selected row, sending it to the amp.
+func (ed *editor) change(d int) error {
+	rows := ed.rows()
+	if ed.row >= len(rows) {
+		ed.row = len(rows) - 1
+	}
+	row := rows[ed.row]
+
+	//Viewing another type leaves the patch alone.
+	if row == rowView {
+		v := ed.views[ed.block] + d
+		if v >= 0 && v < len(ed.effect().Types()) {
+			if ed.views == nil {
+				ed.views = map[int]int{}
+			}
+			ed.views[ed.block] = v
+		}
+		return nil
+	}
+
+	//Problems with the patch are shown, problems with the amp end the session.
+	var perr error
+	err := ed.s.Edit(func(p patch.Patch) error {
+		perr = ed.step(p, row, d)
+		return perr
+	})
+	if perr != nil {
+		ed.status = perr.Error()
+		return nil
+	}
+	return err
+}
+
+func (ed *editor) step(p patch.Patch, row string, d int) error {
+	e := ed.effect()
+	if row == rowOn {
+		on, err := e.On(p)
+		if err != nil {
+			return err
+		}
+		return e.SetOn(p, !on)
+	}
+
+	if row == rowType {
+		t, err := e.Type(p)
+		if err != nil {
+			return err
+		}
+		v := int(t) + d
+		if v < 0 || v > 0x7F {
+			return nil
+		}
+		return e.SetType(p, libktn.Uint7(v))
+	}
+
+	param, err := e.Param(p, ed.view(), row)
+	if err != nil {
+		return err
+	}
+	v, err := patch.Get(p, param)
+	if err != nil {
+		return err
+	}
+
+	max := 0x7F
+	if param.Size == 2 {
+		max = 0x3FFF
+	}
+	n := int(v) + d
+	if n < 0 || n > max {
+		return nil
+	}
+	_, err = patch.Set(p, param, libktn.Uint14(n))
+	return err
+}
+
+func (ed *editor) value(row string) string {
+	e, p := ed.effect(), ed.s.Patch
+	switch row {
+	case rowOn:
+		on, err := e.On(p)
+		if err != nil {
+			return "-"
+		}
+		if on {
+			return "on"
+		}
+		return "off"
+
+	case rowType:
+		t, err := e.Type(p)
+		if err != nil {
+			return "-"
+		}
+		return strconv.Itoa(int(t))
+
+	case rowView:
+		return ed.view()
+
+	default:
+		v, err := e.GetFor(p, ed.view(), row)
+		if err != nil {
+			return "-"
+		}
+		return strconv.Itoa(int(v))
+	}
+}
+
+func (ed *editor) render(w io.Writer) {
+	var b strings.Builder
+	b.WriteString(clearScreen)
+
+	name, _ := patch.Name(ed.s.Patch)
+	fmt.Fprintf(&b, "%s (region %d)\r\n\r\n", name, ed.s.Region)
+
+	for i, e := range patch.Effects {
+		if i == ed.block {
+			fmt.Fprintf(&b, "[%s] ", e.Name)
+		} else {
+			fmt.Fprintf(&b, " %s  ", e.Name)
+		}
+	}
+	b.WriteString("\r\n\r\n")
+
+	for i, row := range ed.rows() {
+		cursor := " "
+		if i == ed.row {
+			cursor = ">"
+		}
+		fmt.Fprintf(&b, "%s %-20s %s\r\n", cursor, row, ed.value(row))
+	}
+
+	b.WriteString("\r\nup/down select, left/right change, tab block, q quit\r\n")
+	if ed.status != "" {
+		fmt.Fprintf(&b, "%s\r\n", ed.status)
+	}
+	io.WriteString(w, b.String())
+}
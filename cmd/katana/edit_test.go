@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/device"
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+func TestKeyDecoder(t *testing.T) {
+	d := keyDecoder{}
+	var keys []key
+	for _, b := range []byte("\x1b[A\x1bOBj\t\x1b[Zx\x1b[5~q") {
+		if k := d.feed(b); k != keyNone {
+			keys = append(keys, k)
+		}
+	}
+	assert.Equal(t, []key{keyUp, keyDown, keyDown, keyTab, keyBackTab, keyQuit}, keys)
+}
+
+func TestEditLoop(t *testing.T) {
+	host, amp := device.Pipe()
+	e := device.NewEmulator(amp, model.KatanaV3)
+	go e.Serve()
+
+	s := device.NewSession(host, sysex.PanelRegion)
+	defer s.Close()
+	assert.Nil(t, s.Load(patch.EncSparse))
+
+	//Switch the booster on, select the next type, then look at the mod block.
+	in := strings.NewReader("\x1b[C\x1b[B\x1b[C\tq")
+	out := bytes.Buffer{}
+	assert.Nil(t, editLoop(s, in, &out))
+
+	screens := strings.Split(out.String(), clearScreen)
+	last := screens[len(screens)-1]
+	assert.True(t, strings.Contains(last, "[mod]"))
+	assert.True(t, strings.Contains(last, "> on "))
+
+	//Round trip so the emulator has seen every command.
+	_, err := s.Identify()
+	assert.Nil(t, err)
+
+	p := e.Patch(sysex.PanelRegion)
+	on, err := patch.EffectBooster.On(p)
+	assert.Nil(t, err)
+	assert.True(t, on)
+	typ, err := patch.EffectBooster.Type(p)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint7(1), typ)
+}
+
+func TestEditorStatus(t *testing.T) {
+	host, amp := device.Pipe()
+	go device.NewEmulator(amp, model.KatanaV3).Serve()
+	s := device.NewSession(host, sysex.PanelRegion)
+	defer s.Close()
+	assert.Nil(t, s.Load(patch.EncSparse))
+
+	//The first type can't go lower, which isn't an error.
+	ed := &editor{s: s, row: 1}
+	_, err := ed.key(keyLeft)
+	assert.Nil(t, err)
+	assert.Equal(t, "", ed.status)
+	assert.Equal(t, "0", ed.value(rowType))
+
+	//Stepping the viewed type shows another type's parameters without editing the patch.
+	ed.block, ed.row = 1, 2
+	assert.Equal(t, "sub_od_ds", ed.value(rowView))
+	_, err = ed.key(keyRight)
+	assert.Nil(t, err)
+	assert.Equal(t, "t_wah", ed.value(rowView))
+	assert.Equal(t, "0", ed.value(rowType))
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+var (
+	ErrFileType = errors.New("Unknown patch file extension")
+	ErrNoRegion = errors.New("No patch region found, use -region to pick one")
+)
+
+var regionNames = map[string]libktn.Uint14{
+	"ch1":   sysex.CH1Region,
+	"ch2":   sysex.CH2Region,
+	"ch3":   sysex.CH3Region,
+	"ch4":   sysex.CH4Region,
+	"panel": sysex.PanelRegion,
+}
+
+//Regions tried in order when none is given.
+var autoRegions = []libktn.Uint14{
+	sysex.PanelRegion, sysex.CH1Region, sysex.CH2Region, sysex.CH3Region, sysex.CH4Region,
+}
+
+func parseRegion(s string) (libktn.Uint14, error) {
+	if r, ok := regionNames[strings.ToLower(s)]; ok {
+		return r, nil
+	}
+	return parseUint14(s)
+}
+
+func parseUint14(s string) (libktn.Uint14, error) {
+	n, err := strconv.ParseUint(s, 0, 14)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid number %q", s)
+	}
+	return libktn.Uint14(n), nil
+}
+
+//Parses hex bytes, ignoring whitespace and an optional 0x prefix on each group.
+func parseHex(args ...string) ([]byte, error) {
+	var b strings.Builder
+	for _, a := range args {
+		for _, f := range strings.Fields(a) {
+			b.WriteString(strings.TrimPrefix(strings.ToLower(f), "0x"))
+		}
+	}
+	return hex.DecodeString(b.String())
+}
+
+//Options for reading patch files.
+type loadOpts struct {
+	//Region of .syx files, "" to use the first one found.
+	region string
+	//Patch of a .tsl liveset.
+	index int
+}
+
+func loadPatch(path string, o loadOpts) (patch.Patch, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".tsl":
+		ps, err := patch.ReadTslFile(path)
+		if unknown, ok := err.(patch.TslUnknownError); ok {
+			//Settings of other devices or newer versions, the patches are still usable.
+			fmt.Fprintln(os.Stderr, unknown)
+		} else if err != nil {
+			return nil, err
+		}
+		if o.index < 0 || o.index >= len(ps) {
+			return nil, fmt.Errorf("Liveset has %d patches", len(ps))
+		}
+		return ps[o.index], nil
+
+	case ".syx":
+		msgs, err := sysex.NewParser(sysex.StrictOptions).ReadFile(path)
+		if skipped, ok := err.(sysex.ReadErrors); ok {
+			//Frames with bad checksums and the like are skipped, as patch.ReadSyx does.
+			fmt.Fprintln(os.Stderr, skipped)
+		} else if err != nil {
+			return nil, err
+		}
+
+		regions := autoRegions
+		if o.region != "" {
+			r, err := parseRegion(o.region)
+			if err != nil {
+				return nil, err
+			}
+			regions = []libktn.Uint14{r}
+		}
+
+		for _, r := range regions {
+			p, err := patch.FromMessages(patch.EncDense, r, msgs)
+			if err == nil {
+				return p, nil
+			}
+			if err != patch.ErrNoPatchData {
+				return nil, err
+			}
+		}
+		return nil, ErrNoRegion
+
+	case ".json", ".yaml", ".yml":
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		if strings.ToLower(filepath.Ext(path)) == ".json" {
+			return patch.ReadJSON(f)
+		}
+		return patch.ReadYAML(f)
+
+	case ".bin":
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return patch.UnmarshalBinary(b)
+
+	default:
+		return nil, ErrFileType
+	}
+}
+
+func savePatch(path string, p patch.Patch, o loadOpts) error {
+	b := bytes.Buffer{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".tsl":
+		n, err := patch.Name(p)
+		if err != nil {
+			return err
+		}
+		if err := patch.WriteTsl(&b, n, []patch.Patch{p}); err != nil {
+			return err
+		}
+
+	case ".syx":
+		r := libktn.Uint14(sysex.PanelRegion)
+		if o.region != "" {
+			var err error
+			if r, err = parseRegion(o.region); err != nil {
+				return err
+			}
+		}
+		if err := patch.WriteSyx(&b, p, r); err != nil {
+			return err
+		}
+
+	case ".json":
+		if err := patch.WriteJSON(&b, p); err != nil {
+			return err
+		}
+
+	case ".yaml", ".yml":
+		if err := patch.WriteYAML(&b, p); err != nil {
+			return err
+		}
+
+	case ".bin":
+		m, ok := p.(encoding.BinaryMarshaler)
+		if !ok {
+			return patch.ErrUnknownEncoding
+		}
+		data, err := m.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		b.Write(data)
+
+	default:
+		return ErrFileType
+	}
+
+	return os.WriteFile(path, b.Bytes(), 0644)
+}
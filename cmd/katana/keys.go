@@ -0,0 +1,85 @@
+package main
+
+import (
+	"io"
+)
+
+//A key press the editor understands.
+type key int
+
+const (
+	keyNone key = iota
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyTab
+	keyBackTab
+	keyQuit
+)
+
+var escKeys = map[byte]key{
+	'A': keyUp,
+	'B': keyDown,
+	'C': keyRight,
+	'D': keyLeft,
+	'Z': keyBackTab,
+}
+
+var plainKeys = map[byte]key{
+	'k':  keyUp,
+	'j':  keyDown,
+	'h':  keyLeft,
+	'l':  keyRight,
+	'-':  keyLeft,
+	'+':  keyRight,
+	'\t': keyTab,
+	'q':  keyQuit,
+	0x03: keyQuit, //Ctrl-C, as raw mode doesn't send signals.
+}
+
+//Turns terminal input into keys, including ANSI arrow key sequences.
+type keyDecoder struct {
+	esc []byte
+}
+
+func (d *keyDecoder) feed(b byte) key {
+	if d.esc != nil {
+		d.esc = append(d.esc, b)
+		switch {
+		case len(d.esc) == 2 && (b == '[' || b == 'O'):
+			return keyNone
+		case len(d.esc) == 3:
+			d.esc = nil
+			return escKeys[b]
+		default:
+			d.esc = nil
+			return keyNone
+		}
+	}
+
+	if b == 0x1B {
+		d.esc = []byte{b}
+		return keyNone
+	}
+	return plainKeys[b]
+}
+
+//Sends keys read from r until it ends, then closes keys.
+func readKeys(r io.Reader, keys chan<- key) {
+	defer close(keys)
+
+	d := keyDecoder{}
+	buf := make([]byte, 32)
+	for {
+		n, err := r.Read(buf)
+		for _, b := range buf[:n] {
+			if k := d.feed(b); k != keyNone {
+				keys <- k
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
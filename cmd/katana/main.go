@@ -0,0 +1,74 @@
+/*
+Command katana inspects and converts Katana sysex messages and patch files.
+
+	katana decode <hex>...
+	katana query <region> <offset> <size>
+	katana command <region> <offset> <hex>
+	katana convert [-region r] [-index n] <in> <out>
+	katana diff [-region r] <a> <b>
+	katana chain [-region r] <file>
+	katana edit [-region r] [-record log] [-device id] -port <device> | -emulate
+	katana replay [-realtime] [-emulate] <log>
+	katana backup -port <device> | -emulate <archive.zip>
+	katana restore [-dry-run] -port <device> | -emulate <archive.zip>
+
+Decode takes sysex messages as well as program and control changes.
+Regions are ch1..ch4, panel or a number. Numbers may be 0x prefixed hex.
+Patch files are picked by extension: .tsl, .syx, .json, .yaml, .yml and .bin for binary patches.
+
+The editor changes the effect blocks of the panel patch with the arrow keys,
+sending every change to the amp right away. Use -emulate to try it without one.
+With several amps on one MIDI port, -device picks the one with that device ID.
+
+With -record all MIDI traffic is logged, as JSON lines or binary when the file ends in .bin.
+Replay prints a log, or with -emulate plays what the host sent into a software amp,
+to reproduce problems without the amp they happened on.
+
+Backup snapshots every channel and the panel of an amp into a zip archive, system settings aren't included.
+Restore writes the patches of one to an amp, only sending what differs. Use -dry-run to list the changes first.
+The amp flags of edit work for backup and restore too.
+*/
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var ErrUsage = errors.New("Usage: katana decode|query|command|convert|diff|chain|edit|replay|backup|restore [flags] args...")
+
+type command func(args []string, out io.Writer) error
+
+var commands = map[string]command{
+	"decode":  runDecode,
+	"query":   runQuery,
+	"command": runCommand,
+	"convert": runConvert,
+	"diff":    runDiff,
+	"chain":   runChain,
+	"edit":    runEdit,
+	"replay":  runReplay,
+	"backup":  runBackup,
+	"restore": runRestore,
+}
+
+func run(args []string, out io.Writer) error {
+	if len(args) < 1 {
+		return ErrUsage
+	}
+
+	c, ok := commands[args[0]]
+	if !ok {
+		return ErrUsage
+	}
+	return c(args[1:], out)
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stvp/assert"
+
+	"github.com/katana-dev/lib-katana/device"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+func runOut(t *testing.T, args ...string) string {
+	buf := bytes.Buffer{}
+	assert.Nil(t, run(args, &buf))
+	return buf.String()
+}
+
+func TestUsage(t *testing.T) {
+	assert.Equal(t, ErrUsage, run(nil, &bytes.Buffer{}))
+	assert.Equal(t, ErrUsage, run([]string{"frobnicate"}, &bytes.Buffer{}))
+	assert.Equal(t, ErrUsage, run([]string{"query", "ch1"}, &bytes.Buffer{}))
+}
+
+func TestQueryDecode(t *testing.T) {
+	q := strings.TrimSpace(runOut(t, "query", "ch1", "0", "0x10"))
+	assert.Equal(t, "F0 41 00 00 00 00 33 11 10 01 00 00 00 00 00 10 5F F7", q)
+
+	out := runOut(t, "decode", q)
+	assert.Equal(t, "RQ1 dev=00 addr=10 01 00 00 (CH1 patch_name1..16) size=16 checksum ok\n", out)
+
+	c := strings.TrimSpace(runOut(t, "command", "panel", "16", "01"))
+	out = runOut(t, "decode", c)
+	assert.Equal(t, "DT1 dev=00 addr=60 00 00 10 (PANEL output_select=1) data=[01] checksum ok\n", out)
+
+	assert.Equal(t, "PC ch=1 program=1\n", runOut(t, "decode", "C0 01"))
+	assert.Equal(t, "CC ch=2 cc=16 value=127\n", runOut(t, "decode", "B1 10 7F"))
+}
+
+func TestConvertDiffChain(t *testing.T) {
+	dir := t.TempDir()
+	p := patch.NewSparse()
+	_, err := patch.SetName(p, "Crunch")
+	assert.Nil(t, err)
+	//The identity chain.
+	chain := make([]byte, 20)
+	for i := range chain {
+		chain[i] = byte(i)
+	}
+	_, err = p.WriteBytes(928, chain)
+	assert.Nil(t, err)
+
+	a := filepath.Join(dir, "a.syx")
+	assert.Nil(t, patch.WriteSyxFile(a, p, 2049))
+
+	//Through every format and back.
+	prev := a
+	for _, ext := range []string{".tsl", ".json", ".yaml", ".bin", ".syx"} {
+		next := filepath.Join(dir, "b"+ext)
+		runOut(t, "convert", prev, next)
+		prev = next
+	}
+	assert.Equal(t, "", runOut(t, "diff", a, prev))
+
+	_, err = patch.SetName(p, "Lead")
+	assert.Nil(t, err)
+	c := filepath.Join(dir, "c.json")
+	f, err := os.Create(c)
+	assert.Nil(t, err)
+	assert.Nil(t, patch.WriteJSON(f, p))
+	assert.Nil(t, f.Close())
+
+	d := runOut(t, "diff", a, c)
+	assert.True(t, strings.HasPrefix(d, "name: \"Crunch\" -> \"Lead\"\n"))
+
+	out := runOut(t, "chain", c)
+	assert.True(t, strings.HasPrefix(out, " 1 0\n 2 1\n"))
+	assert.True(t, strings.HasSuffix(out, "chain_ptn: 0\n"))
+}
+
+func TestLoadSyxSkipsBadFrames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "p.syx")
+	p := patch.NewSparse()
+	_, err := patch.SetName(p, "Kept")
+	assert.Nil(t, err)
+	assert.Nil(t, patch.WriteSyxFile(path, p, sysex.CH1Region))
+
+	//A frame of another device is reported, the patch is still read.
+	b, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	other := []byte{0xF0, 0x43, 0x10, 0x4C, 0x00, 0x00, 0x7E, 0x00, 0xF7}
+	assert.Nil(t, os.WriteFile(path, append(other, b...), 0644))
+
+	r, err := loadPatch(path, loadOpts{})
+	assert.Nil(t, err)
+	n, err := patch.Name(r)
+	assert.Nil(t, err)
+	assert.Equal(t, "Kept", n)
+}
+
+func TestReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traffic.jsonl")
+	f, err := os.Create(path)
+	assert.Nil(t, err)
+	w := device.NewJSONLogWriter(f)
+	q := sysex.MakeQuery(sysex.Address{Region: sysex.PanelRegion, Offset: 0}, 16)
+	b, err := q.Sysex()
+	assert.Nil(t, err)
+	assert.Nil(t, w.WriteFrame(device.Frame{Time: time.Millisecond, Dir: device.Out, Data: b}))
+	assert.Nil(t, f.Close())
+
+	out := runOut(t, "replay", path)
+	assert.Equal(t, "       1ms out RQ1 dev=00 addr=60 00 00 00 (PANEL patch_name1..16) size=16 checksum ok\n", out)
+
+	out = runOut(t, "replay", "-emulate", path)
+	assert.True(t, strings.Contains(out, " out RQ1 "))
+}
+
+func TestBackupRestore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "amp.zip")
+	out := runOut(t, "backup", "-emulate", path)
+	assert.True(t, strings.HasPrefix(out, "[0/5] read ch1\n"))
+	assert.True(t, strings.HasSuffix(out, "[5/5] done\n"))
+
+	out = runOut(t, "restore", "-dry-run", "-emulate", path)
+	assert.True(t, strings.HasSuffix(out, "[10/10] done\n0 changes\n"))
+
+	//The emulated amp answers on the routed device ID.
+	out = runOut(t, "backup", "-emulate", "-device", "3", path)
+	assert.True(t, strings.HasSuffix(out, "[5/5] done\n"))
+
+	assert.Equal(t, ErrUsage, run([]string{"backup", path}, &bytes.Buffer{}))
+}
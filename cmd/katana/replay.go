@@ -0,0 +1,88 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/katana-dev/lib-katana/device"
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+//MIDI logs ending in .bin are binary, anything else is JSON lines.
+func isBinaryLog(path string) bool {
+	return strings.ToLower(filepath.Ext(path)) == ".bin"
+}
+
+func newLogWriter(path string, w io.Writer) device.LogWriter {
+	if isBinaryLog(path) {
+		return device.NewBinaryLogWriter(w)
+	}
+	return device.NewJSONLogWriter(w)
+}
+
+func newLogReader(path string, r io.Reader) device.LogReader {
+	if isBinaryLog(path) {
+		return device.NewBinaryLogReader(r)
+	}
+	return device.NewJSONLogReader(r)
+}
+
+//Prints frames as they're logged.
+type printLog struct {
+	out io.Writer
+	mu  sync.Mutex
+}
+
+func (p *printLog) WriteFrame(f device.Frame) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err := fmt.Fprintf(p.out, "%10s %-3s %s\n", f.Time, f.Dir, sysex.Describe(f.Data))
+	return err
+}
+
+func runReplay(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	realtime := fs.Bool("realtime", false, "keep the original time between messages")
+	emulate := fs.Bool("emulate", false, "send what the host sent to a software amp and show its answers")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return ErrUsage
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	log := newLogReader(fs.Arg(0), f)
+
+	p := &printLog{out: out}
+	if !*emulate {
+		return device.Replay(log, *realtime, p.WriteFrame)
+	}
+
+	host, amp := device.Pipe()
+	go device.NewEmulator(amp, model.KatanaV3).Serve()
+	t := device.NewRecorder(host, p)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			if _, err := t.Receive(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = device.Replay(log, *realtime, device.SendFrames(t, device.Out))
+	t.Close()
+	<-done
+	return err
+}
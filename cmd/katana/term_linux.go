@@ -0,0 +1,38 @@
+//go:build linux
+// +build linux
+
+package main
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+func ioctlTermios(fd uintptr, req uintptr, t *syscall.Termios) error {
+	_, _, e := syscall.Syscall(syscall.SYS_IOCTL, fd, req, uintptr(unsafe.Pointer(t)))
+	if e != 0 {
+		return e
+	}
+	return nil
+}
+
+//Switches a terminal to raw mode, so keys arrive as they are pressed.
+//Returns a function to restore the previous mode.
+func makeRaw(f *os.File) (func(), error) {
+	old := syscall.Termios{}
+	if err := ioctlTermios(f.Fd(), syscall.TCGETS, &old); err != nil {
+		return nil, err
+	}
+
+	raw := old
+	raw.Lflag &^= syscall.ECHO | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Iflag &^= syscall.IXON | syscall.ICRNL
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	if err := ioctlTermios(f.Fd(), syscall.TCSETS, &raw); err != nil {
+		return nil, err
+	}
+
+	return func() { ioctlTermios(f.Fd(), syscall.TCSETS, &old) }, nil
+}
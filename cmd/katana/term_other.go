@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package main
+
+import (
+	"os"
+)
+
+//Raw mode isn't supported here, keys arrive after pressing enter.
+func makeRaw(f *os.File) (func(), error) {
+	return func() {}, nil
+}
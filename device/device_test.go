@@ -0,0 +1,107 @@
+package device
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+func emulated(t *testing.T) (*Session, *Emulator) {
+	host, amp := Pipe()
+	e := NewEmulator(amp, model.KatanaV3)
+
+	p := patch.NewDense()
+	_, err := patch.SetName(p, "Emulated")
+	assert.Nil(t, err)
+	e.SetPatch(sysex.PanelRegion, p)
+
+	go e.Serve()
+	s := NewSession(host, sysex.PanelRegion)
+	return s, e
+}
+
+func TestSessionLoad(t *testing.T) {
+	s, _ := emulated(t)
+	defer s.Close()
+
+	c, err := s.Identify()
+	assert.Nil(t, err)
+	assert.Equal(t, model.KatanaV3, c)
+
+	assert.Nil(t, s.Load(patch.EncodingFor(c.Generation)))
+	n, err := patch.Name(s.Patch)
+	assert.Nil(t, err)
+	assert.Equal(t, "Emulated", n)
+}
+
+func TestSessionEdit(t *testing.T) {
+	s, e := emulated(t)
+	defer s.Close()
+	assert.Nil(t, s.Load(patch.EncSparse))
+
+	assert.Nil(t, s.Edit(func(p patch.Patch) error {
+		return patch.EffectMod.SetFor(p, "phaser", "rate", 40)
+	}))
+
+	//Round trip through the emulator so the command is known to be applied.
+	_, err := s.Identify()
+	assert.Nil(t, err)
+
+	param, _ := patch.SchemaMkI.Param("fx1_phaser_rate")
+	v, err := patch.Get(e.Patch(sysex.PanelRegion), param)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(40), v)
+
+	//Failed edits don't change anything.
+	assert.Equal(t, patch.ErrEffectType, s.Edit(func(p patch.Patch) error {
+		return patch.EffectMod.SetFor(p, "teraecho", "time", 1)
+	}))
+}
+
+func TestSessionUpload(t *testing.T) {
+	s, e := emulated(t)
+	defer s.Close()
+
+	p := patch.NewSparse()
+	_, err := patch.SetName(p, "Uploaded")
+	assert.Nil(t, err)
+	assert.Nil(t, s.Upload(p))
+	assert.Equal(t, p, s.Patch)
+
+	_, err = s.Identify()
+	assert.Nil(t, err)
+	n, err := patch.Name(e.Patch(sysex.PanelRegion))
+	assert.Nil(t, err)
+	assert.Equal(t, "Uploaded", n)
+}
+
+func TestSessionPanelChange(t *testing.T) {
+	s, e := emulated(t)
+	defer s.Close()
+	assert.Nil(t, s.Load(patch.EncSparse))
+
+	assert.Nil(t, e.Turn(sysex.Address{Region: sysex.PanelRegion, Offset: 0}, []byte("Turned")))
+	m := <-s.Messages()
+	assert.True(t, s.Handle(m))
+
+	n, err := patch.Name(s.Patch)
+	assert.Nil(t, err)
+	assert.Equal(t, "Turneded", n)
+
+	//Other regions are left alone.
+	assert.Nil(t, e.Turn(sysex.Address{Region: sysex.CH1Region, Offset: 0}, []byte("X")))
+	assert.False(t, s.Handle(<-s.Messages()))
+}
+
+func TestSessionClosed(t *testing.T) {
+	s, _ := emulated(t)
+	assert.Nil(t, s.Close())
+	_, err := s.Identify()
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrNoPatch, s.Edit(func(p patch.Patch) error { return nil }))
+}
@@ -0,0 +1,129 @@
+package device
+
+import (
+	"io"
+	"sync"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+//A software amp for testing without hardware.
+//It answers ID requests and queries, and applies commands to its patch regions.
+type Emulator struct {
+	Capability *model.Capability
+	//Only messages to this device ID or DevIdAny are handled.
+	//Set it before calling Serve.
+	DeviceId byte
+
+	t   Transport
+	mu  sync.Mutex
+	mem map[libktn.Uint14]patch.Patch
+}
+
+//Creates an emulator of a Katana variant, talking over the given transport.
+func NewEmulator(t Transport, c *model.Capability) *Emulator {
+	e := &Emulator{Capability: c, DeviceId: sysex.DevIdDefault, t: t, mem: map[libktn.Uint14]patch.Patch{}}
+	for r := range sysex.MutablePatchRegions {
+		e.mem[r] = patch.NewDense()
+	}
+	return e
+}
+
+//Answers messages until the transport is closed.
+func (e *Emulator) Serve() error {
+	for {
+		m, err := e.t.Receive()
+		if err == io.EOF || err == io.ErrClosedPipe {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := e.handle(m); err != nil {
+			return err
+		}
+	}
+}
+
+func (e *Emulator) handle(m *sysex.SysexMessage) error {
+	//Other amps may share the bus.
+	if m.DeviceId != e.DeviceId && m.DeviceId != sysex.DevIdAny {
+		return nil
+	}
+
+	switch m.Op {
+	case sysex.OpIdRequest:
+		return e.t.Send(sysex.SysexMessage{Op: sysex.OpIdResponse, DeviceId: e.DeviceId, FirmwareVer: e.Capability.FirmwareMin})
+
+	case sysex.OpQuery:
+		data, ok := e.read(m.Address, int(m.Size))
+		if !ok {
+			//The amp doesn't answer for memory it doesn't have.
+			return nil
+		}
+		return e.t.Send(sysex.MakeCommandFor(e.DeviceId, m.Address, data))
+
+	case sysex.OpCommand:
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		if p, ok := e.mem[m.Address.Region]; ok {
+			p.ApplyMessage(m)
+		}
+	}
+	return nil
+}
+
+func (e *Emulator) read(a sysex.Address, size int) ([]byte, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, ok := e.mem[a.Region]
+	if !ok {
+		return nil, false
+	}
+
+	b := patch.Bytes(p)
+	start, end := int(a.Offset), int(a.Offset)+size
+	if start >= len(b) {
+		return nil, false
+	}
+	if end > len(b) {
+		end = len(b)
+	}
+	return b[start:end], true
+}
+
+//Gets a copy of a patch region, nil if the emulator doesn't have it.
+func (e *Emulator) Patch(region libktn.Uint14) patch.Patch {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, ok := e.mem[region]
+	if !ok {
+		return nil
+	}
+	return p.Clone()
+}
+
+//Replaces a patch region without telling the other side, like selecting a channel would.
+func (e *Emulator) SetPatch(region libktn.Uint14, p patch.Patch) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.mem[region] = p.Clone()
+}
+
+//Simulates turning a knob on the panel, which the amp reports with a command.
+func (e *Emulator) Turn(a sysex.Address, data []byte) error {
+	m := sysex.MakeCommandFor(e.DeviceId, a, data)
+
+	e.mu.Lock()
+	if p, ok := e.mem[a.Region]; ok {
+		p.ApplyMessage(&m)
+	}
+	e.mu.Unlock()
+
+	return e.t.Send(m)
+}
@@ -0,0 +1,311 @@
+package device
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+var (
+	ErrLogMagic       = errors.New("Not a binary MIDI log")
+	ErrLogDirection   = errors.New("Unknown direction in MIDI log")
+	ErrLogFrameLength = errors.New("MIDI log frame is too long")
+)
+
+//Which way a frame went, as seen from this side of the transport.
+type Direction byte
+
+const (
+	In  Direction = iota //Received from the amp.
+	Out                  //Sent to the amp.
+)
+
+var directionNames = [...]string{In: "in", Out: "out"}
+
+func (d Direction) String() string {
+	if int(d) < len(directionNames) {
+		return directionNames[d]
+	}
+	return fmt.Sprintf("Direction(%d)", d)
+}
+
+func parseDirection(s string) (Direction, error) {
+	for d, n := range directionNames {
+		if n == s {
+			return Direction(d), nil
+		}
+	}
+	return 0, ErrLogDirection
+}
+
+//A sysex frame in a MIDI log.
+type Frame struct {
+	//Time since recording started.
+	Time time.Duration
+	Dir  Direction
+	Data []byte
+}
+
+//Writes frames to a MIDI log.
+type LogWriter interface {
+	WriteFrame(f Frame) error
+}
+
+//Reads frames from a MIDI log. Returns io.EOF after the last frame.
+type LogReader interface {
+	ReadFrame() (Frame, error)
+}
+
+//One line of a JSON MIDI log.
+type jsonFrame struct {
+	Time string `json:"time"`
+	Dir  string `json:"dir"`
+	Data string `json:"data"`
+}
+
+type jsonLogWriter struct {
+	enc *json.Encoder
+}
+
+//Creates a log of JSON lines, like:
+//
+//	{"time":"12.5ms","dir":"out","data":"F0 7E 7F 06 01 F7"}
+func NewJSONLogWriter(w io.Writer) LogWriter {
+	return &jsonLogWriter{enc: json.NewEncoder(w)}
+}
+
+func (l *jsonLogWriter) WriteFrame(f Frame) error {
+	return l.enc.Encode(jsonFrame{Time: f.Time.String(), Dir: f.Dir.String(), Data: sysex.FormatHex(f.Data)})
+}
+
+type jsonLogReader struct {
+	dec *json.Decoder
+}
+
+func NewJSONLogReader(r io.Reader) LogReader {
+	return &jsonLogReader{dec: json.NewDecoder(r)}
+}
+
+func (l *jsonLogReader) ReadFrame() (Frame, error) {
+	var j jsonFrame
+	if err := l.dec.Decode(&j); err != nil {
+		return Frame{}, err
+	}
+
+	t, err := time.ParseDuration(j.Time)
+	if err != nil {
+		return Frame{}, err
+	}
+	d, err := parseDirection(j.Dir)
+	if err != nil {
+		return Frame{}, err
+	}
+	data, err := hex.DecodeString(strings.Replace(j.Data, " ", "", -1))
+	if err != nil {
+		return Frame{}, err
+	}
+	return Frame{Time: t, Dir: d, Data: data}, nil
+}
+
+//Binary logs start with this, followed by a version byte.
+//Every frame is an int64 time in nanoseconds, a direction byte,
+//a uint32 length and the data, all big endian.
+const (
+	binaryLogMagic   = "KTNL"
+	binaryLogVersion = 1
+
+	//Far longer than any frame a Katana sends, so a corrupt length can't exhaust memory.
+	maxLogFrameLen = 1 << 20
+)
+
+type binaryLogWriter struct {
+	w      io.Writer
+	header bool
+}
+
+//Creates a compact binary log, for long captures.
+func NewBinaryLogWriter(w io.Writer) LogWriter {
+	return &binaryLogWriter{w: w}
+}
+
+func (l *binaryLogWriter) WriteFrame(f Frame) error {
+	var b []byte
+	if !l.header {
+		b = append(b, binaryLogMagic...)
+		b = append(b, binaryLogVersion)
+		l.header = true
+	}
+
+	var h [13]byte
+	binary.BigEndian.PutUint64(h[0:], uint64(f.Time))
+	h[8] = byte(f.Dir)
+	binary.BigEndian.PutUint32(h[9:], uint32(len(f.Data)))
+	b = append(b, h[:]...)
+	b = append(b, f.Data...)
+
+	_, err := l.w.Write(b)
+	return err
+}
+
+type binaryLogReader struct {
+	r      *bufio.Reader
+	header bool
+}
+
+func NewBinaryLogReader(r io.Reader) LogReader {
+	return &binaryLogReader{r: bufio.NewReader(r)}
+}
+
+func (l *binaryLogReader) ReadFrame() (Frame, error) {
+	if !l.header {
+		var m [len(binaryLogMagic) + 1]byte
+		if _, err := io.ReadFull(l.r, m[:]); err != nil {
+			return Frame{}, ErrLogMagic
+		}
+		if string(m[:len(binaryLogMagic)]) != binaryLogMagic || m[len(binaryLogMagic)] != binaryLogVersion {
+			return Frame{}, ErrLogMagic
+		}
+		l.header = true
+	}
+
+	var h [13]byte
+	if _, err := io.ReadFull(l.r, h[:]); err != nil {
+		//A frame cut short means the recording was, don't fail on it.
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		return Frame{}, err
+	}
+
+	n := binary.BigEndian.Uint32(h[9:])
+	if n > maxLogFrameLen {
+		return Frame{}, ErrLogFrameLength
+	}
+	f := Frame{
+		Time: time.Duration(binary.BigEndian.Uint64(h[0:])),
+		Dir:  Direction(h[8]),
+		Data: make([]byte, n),
+	}
+	if f.Dir > Out {
+		return Frame{}, ErrLogDirection
+	}
+	if _, err := io.ReadFull(l.r, f.Data); err != nil {
+		return Frame{}, io.EOF
+	}
+	return f, nil
+}
+
+type recorder struct {
+	t     Transport
+	log   LogWriter
+	start time.Time
+	mu    sync.Mutex
+}
+
+//Wraps a transport, writing everything that goes through it to a log.
+//Transports over a byte stream, such as NewStream and OpenPort, have received frames logged
+//as they arrived, including the ones of other devices. Others have their messages logged as
+//they'd be sent, skipping the ones that can't be.
+//Errors writing the log are returned from Send and Receive.
+func NewRecorder(t Transport, log LogWriter) Transport {
+	return &recorder{t: t, log: log, start: time.Now()}
+}
+
+func (r *recorder) record(d Direction, b []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.log.WriteFrame(Frame{Time: time.Since(r.start), Dir: d, Data: b})
+}
+
+//Logs a message without its raw frame.
+func (r *recorder) recordMessage(d Direction, m sysex.SysexMessage) error {
+	b, err := m.Sysex()
+	if err != nil {
+		//It went through the transport all the same, not being able to log it is no reason to fail.
+		return nil
+	}
+	return r.record(d, b)
+}
+
+//Logs the message before sending it, as the reply can arrive before Send returns.
+//A message that fails to send is still logged.
+func (r *recorder) Send(m sysex.SysexMessage) error {
+	if err := r.recordMessage(Out, m); err != nil {
+		return err
+	}
+	return r.t.Send(m)
+}
+
+func (r *recorder) Receive() (*sysex.SysexMessage, error) {
+	fr, ok := r.t.(frameReceiver)
+	if !ok {
+		m, err := r.t.Receive()
+		if err != nil {
+			return nil, err
+		}
+		return m, r.recordMessage(In, *m)
+	}
+
+	for {
+		b, m, err := fr.receiveFrame()
+		if err != nil {
+			return nil, err
+		}
+		if err := r.record(In, b); err != nil {
+			return nil, err
+		}
+		if m != nil {
+			return m, nil
+		}
+	}
+}
+
+func (r *recorder) Close() error {
+	return r.t.Close()
+}
+
+//Plays back a log, calling play for every frame.
+//With realtime the original time between frames is kept, otherwise it plays as fast as possible.
+func Replay(log LogReader, realtime bool, play func(f Frame) error) error {
+	start := time.Now()
+	for {
+		f, err := log.ReadFrame()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if realtime {
+			time.Sleep(f.Time - time.Since(start))
+		}
+		if err := play(f); err != nil {
+			return err
+		}
+	}
+}
+
+//Sends the frames that went one way to a transport, such as the Out frames to an Emulator.
+//Frames that aren't valid Katana messages are skipped, like a Transport would.
+func SendFrames(t Transport, d Direction) func(f Frame) error {
+	return func(f Frame) error {
+		if f.Dir != d {
+			return nil
+		}
+		m, err := sysex.Parse(f.Data)
+		if err != nil {
+			return nil
+		}
+		return t.Send(*m)
+	}
+}
@@ -0,0 +1,200 @@
+package device
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stvp/assert"
+
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+func TestRecordReplay(t *testing.T) {
+	host, amp := Pipe()
+	e := NewEmulator(amp, model.KatanaV3)
+	go e.Serve()
+
+	log := &bytes.Buffer{}
+	s := NewSession(NewRecorder(host, NewJSONLogWriter(log)), sysex.PanelRegion)
+	assert.Nil(t, s.Load(patch.EncSparse))
+	assert.Nil(t, s.Edit(func(p patch.Patch) error {
+		_, err := patch.SetName(p, "Recorded")
+		return err
+	}))
+	_, err := s.Identify()
+	assert.Nil(t, err)
+	s.Close()
+
+	//Play what the host sent into a fresh amp, which should end up the same.
+	host, amp = Pipe()
+	fresh := NewEmulator(amp, model.KatanaV3)
+	go fresh.Serve()
+	defer host.Close()
+
+	answers := make(chan *sysex.SysexMessage, 64)
+	go func() {
+		for {
+			m, err := host.Receive()
+			if err != nil {
+				close(answers)
+				return
+			}
+			answers <- m
+		}
+	}()
+
+	dirs := map[Direction]int{}
+	assert.Nil(t, Replay(NewJSONLogReader(log), false, func(f Frame) error {
+		dirs[f.Dir]++
+		return SendFrames(host, Out)(f)
+	}))
+	assert.True(t, dirs[In] > 0)
+	assert.True(t, dirs[Out] > 0)
+
+	for m := range answers {
+		if m.Op == sysex.OpIdResponse {
+			break
+		}
+	}
+	assert.True(t, patch.Equal(e.Patch(sysex.PanelRegion), fresh.Patch(sysex.PanelRegion)))
+	n, err := patch.Name(fresh.Patch(sysex.PanelRegion))
+	assert.Nil(t, err)
+	assert.Equal(t, "Recorded", n)
+}
+
+type rawPort struct {
+	io.Reader
+	io.Writer
+}
+
+func (rawPort) Close() error { return nil }
+
+func TestRecordRawFrames(t *testing.T) {
+	q := sysex.MakeQuery(sysex.Address{Region: sysex.PanelRegion}, 4)
+	b, err := q.Sysex()
+	assert.Nil(t, err)
+	badSum := append([]byte{}, b...)
+	badSum[len(badSum)-2] ^= 1
+	devId := append([]byte{}, b...)
+	devId[2] = 0x20
+	yamaha := []byte{0xF0, 0x43, 0x10, 0x4C, 0x00, 0x00, 0x7E, 0x00, 0xF7}
+
+	in := bytes.Buffer{}
+	for _, f := range [][]byte{badSum, yamaha, devId} {
+		in.Write(f)
+	}
+
+	log := &bytes.Buffer{}
+	r := NewRecorder(NewStream(rawPort{&in, io.Discard}), NewBinaryLogWriter(log))
+	for i := 0; i < 2; i++ {
+		_, err := r.Receive()
+		assert.Nil(t, err)
+	}
+	_, err = r.Receive()
+	assert.Equal(t, io.EOF, err)
+
+	//Frames are logged as they arrived, not as they'd be sent.
+	lr := NewBinaryLogReader(log)
+	for _, exp := range [][]byte{badSum, yamaha, devId} {
+		f, err := lr.ReadFrame()
+		assert.Nil(t, err)
+		assert.Equal(t, In, f.Dir)
+		assert.Equal(t, exp, f.Data)
+	}
+}
+
+func TestBinaryLog(t *testing.T) {
+	frames := []Frame{
+		{Time: 0, Dir: Out, Data: []byte{0xF0, 0x7E, 0x7F, 0x06, 0x01, 0xF7}},
+		{Time: 1500 * time.Microsecond, Dir: In, Data: []byte{0xF0, 0xF7}},
+	}
+
+	b := &bytes.Buffer{}
+	w := NewBinaryLogWriter(b)
+	for _, f := range frames {
+		assert.Nil(t, w.WriteFrame(f))
+	}
+
+	r := NewBinaryLogReader(bytes.NewReader(b.Bytes()))
+	for _, exp := range frames {
+		f, err := r.ReadFrame()
+		assert.Nil(t, err)
+		assert.Equal(t, exp, f)
+	}
+	_, err := r.ReadFrame()
+	assert.Equal(t, io.EOF, err)
+
+	_, err = NewBinaryLogReader(bytes.NewReader([]byte("KTNP\x01"))).ReadFrame()
+	assert.Equal(t, ErrLogMagic, err)
+
+	//A length the file can't back is rejected before allocating it.
+	huge := []byte("KTNL\x01\x00\x00\x00\x00\x00\x00\x00\x00\x01\xFF\xFF\xFF\xFF")
+	_, err = NewBinaryLogReader(bytes.NewReader(huge)).ReadFrame()
+	assert.Equal(t, ErrLogFrameLength, err)
+}
+
+func TestReplayRealtime(t *testing.T) {
+	b := &bytes.Buffer{}
+	w := NewJSONLogWriter(b)
+	assert.Nil(t, w.WriteFrame(Frame{Time: 20 * time.Millisecond, Dir: In, Data: []byte{0xF0, 0xF7}}))
+	assert.True(t, bytes.Contains(b.Bytes(), []byte(`"time":"20ms","dir":"in","data":"F0 F7"`)))
+
+	start := time.Now()
+	assert.Nil(t, Replay(NewJSONLogReader(b), true, func(f Frame) error { return nil }))
+	assert.True(t, time.Since(start) >= 20*time.Millisecond)
+}
+
+//Receives a reply as part of sending, as a fast amp on another goroutine can.
+type echoTransport struct {
+	log   *frameLog
+	sends int
+}
+
+func (e *echoTransport) Send(m sysex.SysexMessage) error {
+	e.sends++
+	//The request has to be in the log before any reply.
+	if len(e.log.frames) != e.sends*2-1 || e.log.frames[len(e.log.frames)-1].Dir != Out {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (e *echoTransport) Receive() (*sysex.SysexMessage, error) {
+	m := sysex.MakeIdRequest()
+	return &m, nil
+}
+
+func (e *echoTransport) Close() error {
+	return nil
+}
+
+type frameLog struct {
+	frames []Frame
+}
+
+func (l *frameLog) WriteFrame(f Frame) error {
+	l.frames = append(l.frames, f)
+	return nil
+}
+
+func TestRecordOrder(t *testing.T) {
+	log := &frameLog{}
+	r := NewRecorder(&echoTransport{log: log}, log)
+	for i := 0; i < 2; i++ {
+		assert.Nil(t, r.Send(sysex.MakeIdRequest()))
+		_, err := r.Receive()
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, 4, len(log.frames))
+	for i, f := range log.frames {
+		assert.Equal(t, Direction(1-i%2), f.Dir)
+		if i > 0 {
+			assert.True(t, f.Time >= log.frames[i-1].Time)
+		}
+	}
+}
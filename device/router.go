@@ -0,0 +1,120 @@
+package device
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+var ErrDeviceRouted = errors.New("Device ID is already routed")
+
+//Shares one transport between several amps on the same MIDI bus,
+//each set to a different device ID.
+//Messages from device IDs without a route are dropped.
+type Router struct {
+	t    Transport
+	mu   sync.Mutex
+	devs map[byte]*route
+	done chan struct{}
+	err  error
+}
+
+//Starts routing the messages a transport receives.
+func NewRouter(t Transport) *Router {
+	r := &Router{t: t, devs: map[byte]*route{}, done: make(chan struct{})}
+	go r.dispatch()
+	return r
+}
+
+func (r *Router) dispatch() {
+	for {
+		m, err := r.t.Receive()
+		if err != nil {
+			r.err = err
+			close(r.done)
+			return
+		}
+
+		r.mu.Lock()
+		d := r.devs[m.DeviceId]
+		r.mu.Unlock()
+		if d == nil {
+			continue
+		}
+
+		//A device that stops receiving holds up the others, until it's closed.
+		select {
+		case d.msgs <- m:
+		case <-d.done:
+		}
+	}
+}
+
+//Creates a transport for the amp with a device ID.
+//Everything sent through it is addressed to that amp,
+//and it only receives the messages that amp sends.
+func (r *Router) Device(id byte) (Transport, error) {
+	if id > sysex.DevIdMax {
+		return nil, sysex.ErrBadDeviceId
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.devs[id]; ok {
+		return nil, ErrDeviceRouted
+	}
+
+	d := &route{r: r, id: id, msgs: make(chan *sysex.SysexMessage, 64), done: make(chan struct{})}
+	r.devs[id] = d
+	return d, nil
+}
+
+//Closes the shared transport, which ends every device's transport.
+func (r *Router) Close() error {
+	return r.t.Close()
+}
+
+type route struct {
+	r    *Router
+	id   byte
+	msgs chan *sysex.SysexMessage
+	done chan struct{}
+	once sync.Once
+}
+
+func (d *route) Send(m sysex.SysexMessage) error {
+	m.DeviceId = d.id
+	return d.r.t.Send(m)
+}
+
+func (d *route) Receive() (*sysex.SysexMessage, error) {
+	select {
+	case m := <-d.msgs:
+		return m, nil
+
+	case <-d.done:
+		return nil, io.EOF
+
+	case <-d.r.done:
+		//Hand out what arrived before the shared transport closed.
+		select {
+		case m := <-d.msgs:
+			return m, nil
+		default:
+			return nil, d.r.err
+		}
+	}
+}
+
+//Removes the route, the shared transport stays open.
+func (d *route) Close() error {
+	d.once.Do(func() {
+		d.r.mu.Lock()
+		delete(d.r.devs, d.id)
+		d.r.mu.Unlock()
+		close(d.done)
+	})
+	return nil
+}
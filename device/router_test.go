@@ -0,0 +1,112 @@
+package device
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stvp/assert"
+
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+//Chains emulated amps on one bus, like a MIDI interface with several amps.
+func bus(t *testing.T, ids ...byte) Transport {
+	host, hub := Pipe()
+
+	var amps []Transport
+	for _, id := range ids {
+		hubAmp, amp := Pipe()
+		amps = append(amps, hubAmp)
+
+		e := NewEmulator(amp, model.KatanaV3)
+		e.DeviceId = id
+		p := patch.NewDense()
+		_, err := patch.SetName(p, fmt.Sprintf("Amp %d", id))
+		assert.Nil(t, err)
+		e.SetPatch(sysex.PanelRegion, p)
+		go e.Serve()
+
+		go func() {
+			for {
+				m, err := hubAmp.Receive()
+				if err != nil {
+					return
+				}
+				hub.Send(*m)
+			}
+		}()
+	}
+
+	go func() {
+		defer func() {
+			for _, a := range amps {
+				a.Close()
+			}
+		}()
+		for {
+			m, err := hub.Receive()
+			if err != nil {
+				return
+			}
+			for _, a := range amps {
+				a.Send(*m)
+			}
+		}
+	}()
+	return host
+}
+
+func TestRouterSessions(t *testing.T) {
+	r := NewRouter(bus(t, 0, 1, 5))
+	defer r.Close()
+
+	for _, id := range []byte{5, 0, 1} {
+		dt, err := r.Device(id)
+		assert.Nil(t, err)
+		s := NewSession(dt, sysex.PanelRegion)
+
+		_, err = s.Identify()
+		assert.Nil(t, err)
+		assert.Nil(t, s.Load(patch.EncSparse))
+		n, err := patch.Name(s.Patch)
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("Amp %d", id), n)
+		s.Close()
+	}
+}
+
+func TestRouterDevice(t *testing.T) {
+	host, amp := Pipe()
+	r := NewRouter(host)
+	defer r.Close()
+
+	_, err := r.Device(sysex.DevIdAny)
+	assert.Equal(t, sysex.ErrBadDeviceId, err)
+
+	two, err := r.Device(2)
+	assert.Nil(t, err)
+	_, err = r.Device(2)
+	assert.Equal(t, ErrDeviceRouted, err)
+
+	//Sending addresses the device.
+	go two.Send(sysex.MakeIdRequest())
+	m, err := amp.Receive()
+	assert.Nil(t, err)
+	assert.Equal(t, byte(2), m.DeviceId)
+
+	//Only its own messages arrive.
+	assert.Nil(t, amp.Send(sysex.MakeCommandFor(3, sysex.Address{Region: sysex.PanelRegion, Offset: 16}, []byte{0x03})))
+	assert.Nil(t, amp.Send(sysex.MakeCommandFor(2, sysex.Address{Region: sysex.PanelRegion, Offset: 16}, []byte{0x02})))
+	m, err = two.Receive()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x02}, m.Data)
+
+	//Closed routes can be made again.
+	assert.Nil(t, two.Close())
+	_, err = two.Receive()
+	assert.NotNil(t, err)
+	_, err = r.Device(2)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,180 @@
+package device
+
+import (
+	"errors"
+	"time"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/model"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+const DefaultTimeout = 2 * time.Second
+
+var (
+	ErrTimeout = errors.New("Timed out waiting for the amp")
+	ErrClosed  = errors.New("Connection to the amp was closed")
+	ErrNoPatch = errors.New("No patch was loaded in this session")
+)
+
+//Keeps a local copy of a patch region in sync with an amp.
+//Messages arrive on the Messages channel, which should be passed to Handle
+//by the same goroutine that makes changes, so no locking is needed.
+type Session struct {
+	Region  libktn.Uint14
+	Patch   patch.Patch
+	Timeout time.Duration
+
+	t    Transport
+	msgs chan *sysex.SysexMessage
+	err  error
+}
+
+//Starts a session for a patch region, receiving messages in the background.
+func NewSession(t Transport, region libktn.Uint14) *Session {
+	s := &Session{Region: region, Timeout: DefaultTimeout, t: t, msgs: make(chan *sysex.SysexMessage, 64)}
+	go s.receive()
+	return s
+}
+
+func (s *Session) receive() {
+	for {
+		m, err := s.t.Receive()
+		if err != nil {
+			s.err = err
+			close(s.msgs)
+			return
+		}
+		s.msgs <- m
+	}
+}
+
+//Incoming messages. The channel is closed when the transport is, see Err.
+func (s *Session) Messages() <-chan *sysex.SysexMessage {
+	return s.msgs
+}
+
+//The reason Messages was closed.
+func (s *Session) Err() error {
+	return s.err
+}
+
+//Applies commands for the session's region to the local patch.
+//Returns whether the patch changed.
+func (s *Session) Handle(m *sysex.SysexMessage) bool {
+	if s.Patch == nil || m.Op != sysex.OpCommand || m.Address.Region != s.Region {
+		return false
+	}
+	return s.Patch.ApplyMessage(m).Written() > 0
+}
+
+//Waits for a message done accepts, handling everything that arrives meanwhile.
+func (s *Session) wait(done func(m *sysex.SysexMessage) bool) (*sysex.SysexMessage, error) {
+	timer := time.NewTimer(s.Timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case m, ok := <-s.msgs:
+			if !ok {
+				return nil, ErrClosed
+			}
+			s.Handle(m)
+			if done(m) {
+				return m, nil
+			}
+		case <-timer.C:
+			return nil, ErrTimeout
+		}
+	}
+}
+
+//Asks the amp which Katana variant it is.
+func (s *Session) Identify() (*model.Capability, error) {
+	if err := s.t.Send(sysex.MakeIdRequest()); err != nil {
+		return nil, err
+	}
+
+	m, err := s.wait(func(m *sysex.SysexMessage) bool { return m.Op == sysex.OpIdResponse })
+	if err != nil {
+		return nil, err
+	}
+	return m.Capability()
+}
+
+//Reads the session's region from the amp into a new patch.
+func (s *Session) Load(enc uint16) error {
+	qs, err := patch.Queries(enc, s.Region, patch.DefaultChunkSize)
+	if err != nil {
+		return err
+	}
+
+	p, err := patch.New(enc)
+	if err != nil {
+		return err
+	}
+	s.Patch = p
+
+	want := 0
+	for _, q := range qs {
+		if err := s.t.Send(q); err != nil {
+			return err
+		}
+		want += int(q.Size)
+	}
+
+	got := 0
+	_, err = s.wait(func(m *sysex.SysexMessage) bool {
+		if m.Op == sysex.OpCommand && m.Address.Region == s.Region {
+			got += len(m.Data)
+		}
+		return got >= want
+	})
+	return err
+}
+
+//Writes a whole patch to the session's region, which then becomes the session's patch.
+func (s *Session) Upload(p patch.Patch) error {
+	msgs, err := patch.Commands(p, s.Region, patch.DefaultChunkSize)
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if err := s.t.Send(m); err != nil {
+			return err
+		}
+	}
+	s.Patch = p
+	return nil
+}
+
+//Makes changes to the patch, sending the bytes that changed to the amp right away.
+//The patch is left as is when edit returns an error.
+func (s *Session) Edit(edit func(p patch.Patch) error) error {
+	if s.Patch == nil {
+		return ErrNoPatch
+	}
+
+	c := s.Patch.Clone()
+	if err := edit(c); err != nil {
+		return err
+	}
+
+	msgs, err := patch.DiffCommands(s.Patch, c, s.Region, patch.DefaultChunkSize)
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if err := s.t.Send(m); err != nil {
+			return err
+		}
+	}
+	s.Patch = c
+	return nil
+}
+
+//Closes the transport, which also closes Messages.
+func (s *Session) Close() error {
+	return s.t.Close()
+}
@@ -0,0 +1,111 @@
+package device
+
+import (
+	"io"
+	"os"
+	"sync"
+
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+//Sends and receives sysex messages, such as over a MIDI port.
+//Send may be called from several goroutines, Receive from one at a time.
+type Transport interface {
+	Send(m sysex.SysexMessage) error
+	//Blocks until the next message arrives. Returns io.EOF once the transport is closed.
+	Receive() (*sysex.SysexMessage, error)
+	Close() error
+}
+
+//Implemented by transports that can hand out frames as they arrived, see NewRecorder.
+type frameReceiver interface {
+	//Returns the next sysex frame, with its message when it's a Katana one.
+	receiveFrame() ([]byte, *sysex.SysexMessage, error)
+}
+
+type stream struct {
+	r  *sysex.Reader
+	rw io.ReadWriteCloser
+	mu sync.Mutex
+}
+
+//Parses live traffic, where a bad checksum is no reason to drop a message.
+var liveParser = sysex.NewParser(sysex.LenientOptions)
+
+//Creates a Transport over a raw MIDI byte stream.
+//Frames that aren't valid Katana messages are skipped.
+func NewStream(rw io.ReadWriteCloser) Transport {
+	return &stream{r: sysex.NewReader(rw), rw: rw}
+}
+
+//Opens a raw MIDI device, such as /dev/snd/midiC1D0 on Linux.
+func OpenPort(path string) (Transport, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	return NewStream(f), nil
+}
+
+func (s *stream) Send(m sysex.SysexMessage) error {
+	b, err := m.Sysex()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.rw.Write(b)
+	return err
+}
+
+func (s *stream) Receive() (*sysex.SysexMessage, error) {
+	for {
+		_, m, err := s.receiveFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		//Other devices may share the port, only Katana messages are of interest.
+		if m != nil {
+			return m, nil
+		}
+	}
+}
+
+func (s *stream) receiveFrame() ([]byte, *sysex.SysexMessage, error) {
+	f, err := s.r.ReadFrame()
+	if err != nil {
+		return nil, nil, err
+	}
+	m, err := liveParser.Parse(f)
+	if err != nil {
+		return f, nil, nil
+	}
+	return f, m, nil
+}
+
+func (s *stream) Close() error {
+	return s.rw.Close()
+}
+
+type pipeEnd struct {
+	io.Reader
+	io.Writer
+	r *io.PipeReader
+	w *io.PipeWriter
+}
+
+func (p *pipeEnd) Close() error {
+	p.w.Close()
+	return p.r.Close()
+}
+
+//Creates two connected in memory transports, to talk to an Emulator for example.
+func Pipe() (Transport, Transport) {
+	ar, bw := io.Pipe()
+	br, aw := io.Pipe()
+	a := &pipeEnd{Reader: ar, Writer: aw, r: ar, w: aw}
+	b := &pipeEnd{Reader: br, Writer: bw, r: br, w: bw}
+	return NewStream(a), NewStream(b)
+}
@@ -12,7 +12,7 @@ var (
 type RequiredError string
 
 func (e RequiredError) Error() string {
-	return fmt.Sprintf("%s is a required field")
+	return fmt.Sprintf("%s is a required field", string(e))
 }
 
 type SliceLengthError []int
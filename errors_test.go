@@ -0,0 +1,11 @@
+package libktn
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+func TestRequiredError(t *testing.T) {
+	assert.Equal(t, "name is a required field", RequiredError("name").Error())
+}
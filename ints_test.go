@@ -1,6 +1,7 @@
 package libktn
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stvp/assert"
@@ -128,3 +129,39 @@ func TestMakeUint28(t *testing.T) {
 	assert.Equal(t, SliceLengthError{4}, e)
 	assert.Equal(t, Uint28(0), v)
 }
+
+func FuzzMakeUint14(f *testing.F) {
+	f.Add([]byte{0x00, 0x00})
+	f.Add([]byte{0x7F, 0x7F})
+	f.Add([]byte{0x7F, 0xFF})
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		u, err := MakeUint14(b)
+		if err != nil {
+			return
+		}
+		assert.True(t, u <= sysexShortMax)
+
+		s, err := u.Sysex()
+		assert.Nil(t, err)
+		assert.True(t, bytes.Equal(b, s))
+	})
+}
+
+func FuzzMakeUint28(f *testing.F) {
+	f.Add([]byte{0x00, 0x00, 0x00, 0x00})
+	f.Add([]byte{0x7F, 0x7F, 0x7F, 0x7F})
+	f.Add([]byte{0x00, 0x80, 0x00, 0x00})
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		u, err := MakeUint28(b)
+		if err != nil {
+			return
+		}
+		assert.True(t, u <= sysexWordMax)
+
+		s, err := u.Sysex()
+		assert.Nil(t, err)
+		assert.True(t, bytes.Equal(b, s))
+	})
+}
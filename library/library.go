@@ -0,0 +1,289 @@
+package library
+
+import (
+	"errors"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+var (
+	ErrSlotIndex  = errors.New("Slot index is out of range for this bank")
+	ErrFixedBank  = errors.New("Bank has a fixed number of slots")
+	ErrEmptySlot  = errors.New("Slot does not contain a patch")
+	ErrUnknownVer = errors.New("Unknown library file version")
+	ErrBankSlots  = errors.New("Bank has a different number of regions and slots")
+)
+
+//The slots of the amp itself, in the order they are presented on the panel.
+var AmpRegions = []libktn.Uint14{
+	sysex.PanelRegion,
+	sysex.CH1Region,
+	sysex.CH2Region,
+	sysex.CH3Region,
+	sysex.CH4Region,
+}
+
+//A single patch in the library, along with the metadata the amp doesn't store.
+type Entry struct {
+	Category string
+	Tags     []string
+	Patch    patch.Patch
+}
+
+//Creates a new entry for a patch.
+func NewEntry(p patch.Patch) *Entry {
+	return &Entry{Patch: p}
+}
+
+//Reads the name of the patch from the patch_name1..16 parameters.
+//Trailing padding is removed.
+func (e *Entry) Name() string {
+	if e.Patch == nil {
+		return ""
+	}
+
+	n, _ := patch.Name(e.Patch)
+	return n
+}
+
+//Tests whether the entry has been tagged with the given tag.
+func (e *Entry) HasTag(tag string) bool {
+	for _, t := range e.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+//Adds a tag to the entry, if it isn't present already.
+func (e *Entry) AddTag(tag string) {
+	if !e.HasTag(tag) {
+		e.Tags = append(e.Tags, tag)
+	}
+}
+
+//Removes a tag from the entry.
+func (e *Entry) RemoveTag(tag string) {
+	for i, t := range e.Tags {
+		if t == tag {
+			e.Tags = append(e.Tags[:i], e.Tags[i+1:]...)
+			return
+		}
+	}
+}
+
+//Creates a deep copy of the entry, including the patch memory.
+func (e *Entry) Copy() (*Entry, error) {
+	c := &Entry{Category: e.Category}
+	if e.Tags != nil {
+		c.Tags = make([]string, len(e.Tags))
+		copy(c.Tags, e.Tags)
+	}
+
+	if e.Patch == nil {
+		return c, nil
+	}
+
+	c.Patch = e.Patch.Clone()
+	return c, nil
+}
+
+//A collection of patch slots.
+//Amp banks mirror the memory regions of the amp and have a fixed size,
+//user collections can grow and shrink as needed.
+type Bank struct {
+	Name    string
+	Regions []libktn.Uint14
+	Entries []*Entry
+}
+
+//Creates a bank with a slot for the panel and each channel of the amp.
+func NewAmpBank(name string) *Bank {
+	r := make([]libktn.Uint14, len(AmpRegions))
+	copy(r, AmpRegions)
+	return &Bank{Name: name, Regions: r, Entries: make([]*Entry, len(r))}
+}
+
+//Creates an empty user collection.
+func NewCollection(name string) *Bank {
+	return &Bank{Name: name}
+}
+
+//Whether the number of slots in this bank can change.
+func (b *Bank) Fixed() bool {
+	return b.Regions != nil
+}
+
+//Number of slots in this bank.
+func (b *Bank) Len() int {
+	return len(b.Entries)
+}
+
+//Gets the entry in a slot, which may be nil for an empty slot.
+func (b *Bank) Get(i int) (*Entry, error) {
+	if !b.valid(i) {
+		return nil, ErrSlotIndex
+	}
+	return b.Entries[i], nil
+}
+
+//Replaces the entry in a slot.
+func (b *Bank) Set(i int, e *Entry) error {
+	if !b.valid(i) {
+		return ErrSlotIndex
+	}
+	b.Entries[i] = e
+	return nil
+}
+
+//Gets the amp memory region backing a slot.
+//Returns false for user collections.
+func (b *Bank) Region(i int) (libktn.Uint14, bool) {
+	if !b.Fixed() || !b.valid(i) {
+		return 0, false
+	}
+	return b.Regions[i], true
+}
+
+//Finds the slot backed by an amp memory region.
+//Returns -1 when no slot is found.
+func (b *Bank) SlotOf(region libktn.Uint14) int {
+	for i, r := range b.Regions {
+		if r == region {
+			return i
+		}
+	}
+	return -1
+}
+
+//Inserts an entry before the given slot. Use Len() to append.
+func (b *Bank) Insert(i int, e *Entry) error {
+	if b.Fixed() {
+		return ErrFixedBank
+	}
+	if i < 0 || i > len(b.Entries) {
+		return ErrSlotIndex
+	}
+
+	b.Entries = append(b.Entries, nil)
+	copy(b.Entries[i+1:], b.Entries[i:])
+	b.Entries[i] = e
+	return nil
+}
+
+//Appends an entry to a user collection.
+func (b *Bank) Append(e *Entry) error {
+	return b.Insert(len(b.Entries), e)
+}
+
+//Removes a slot from a user collection, returning the entry it held.
+func (b *Bank) Remove(i int) (*Entry, error) {
+	if b.Fixed() {
+		return nil, ErrFixedBank
+	}
+	if !b.valid(i) {
+		return nil, ErrSlotIndex
+	}
+
+	e := b.Entries[i]
+	b.Entries = append(b.Entries[:i], b.Entries[i+1:]...)
+	return e, nil
+}
+
+//Moves an entry to a new slot, shifting the slots in between.
+//For amp banks the regions stay in place and only the entries move.
+func (b *Bank) Move(from, to int) error {
+	if !b.valid(from) || !b.valid(to) {
+		return ErrSlotIndex
+	}
+
+	e := b.Entries[from]
+	if from < to {
+		copy(b.Entries[from:to], b.Entries[from+1:to+1])
+	} else {
+		copy(b.Entries[to+1:from+1], b.Entries[to:from])
+	}
+	b.Entries[to] = e
+	return nil
+}
+
+//Swaps the entries of two slots.
+func (b *Bank) Swap(i, j int) error {
+	if !b.valid(i) || !b.valid(j) {
+		return ErrSlotIndex
+	}
+
+	b.Entries[i], b.Entries[j] = b.Entries[j], b.Entries[i]
+	return nil
+}
+
+//Copies an entry into another slot of this bank, overwriting it.
+func (b *Bank) Copy(from, to int) error {
+	return b.CopyTo(from, b, to)
+}
+
+//Copies an entry into a slot of another bank, overwriting it.
+func (b *Bank) CopyTo(from int, dst *Bank, to int) error {
+	if !b.valid(from) || !dst.valid(to) {
+		return ErrSlotIndex
+	}
+	if b.Entries[from] == nil {
+		return ErrEmptySlot
+	}
+
+	c, err := b.Entries[from].Copy()
+	if err != nil {
+		return err
+	}
+	dst.Entries[to] = c
+	return nil
+}
+
+func (b *Bank) valid(i int) bool {
+	return i >= 0 && i < len(b.Entries)
+}
+
+//A set of banks making up a patch library.
+type Library struct {
+	Banks []*Bank
+}
+
+//Creates a new library with a single amp bank.
+func New() *Library {
+	return &Library{Banks: []*Bank{NewAmpBank("Amp")}}
+}
+
+//Finds a bank by name, returns nil when it's not found.
+func (l *Library) Bank(name string) *Bank {
+	for _, b := range l.Banks {
+		if b.Name == name {
+			return b
+		}
+	}
+	return nil
+}
+
+//Lists all entries that have the given tag.
+func (l *Library) FindTag(tag string) []*Entry {
+	return l.filter(func(e *Entry) bool { return e.HasTag(tag) })
+}
+
+//Lists all entries in the given category.
+func (l *Library) FindCategory(category string) []*Entry {
+	return l.filter(func(e *Entry) bool { return e.Category == category })
+}
+
+func (l *Library) filter(f func(*Entry) bool) []*Entry {
+	var r []*Entry
+	for _, b := range l.Banks {
+		for _, e := range b.Entries {
+			if e != nil && f(e) {
+				r = append(r, e)
+			}
+		}
+	}
+	return r
+}
@@ -0,0 +1,147 @@
+package library
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stvp/assert"
+
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+func namedEntry(t *testing.T, name string) *Entry {
+	p, err := patch.New(patch.EncSparse)
+	assert.Nil(t, err)
+	_, err = p.WriteBytes(0, []byte(name))
+	assert.Nil(t, err)
+	return NewEntry(p)
+}
+
+func names(b *Bank) []string {
+	n := make([]string, b.Len())
+	for i, e := range b.Entries {
+		if e != nil {
+			n[i] = e.Name()
+		}
+	}
+	return n
+}
+
+func TestEntryName(t *testing.T) {
+	e := namedEntry(t, "Crunch          ")
+	assert.Equal(t, "Crunch", e.Name())
+	assert.Equal(t, "", NewEntry(nil).Name())
+}
+
+func TestEntryTags(t *testing.T) {
+	e := NewEntry(nil)
+	e.AddTag("blues")
+	e.AddTag("lead")
+	e.AddTag("blues")
+	assert.Equal(t, []string{"blues", "lead"}, e.Tags)
+	assert.True(t, e.HasTag("lead"))
+
+	e.RemoveTag("blues")
+	assert.Equal(t, []string{"lead"}, e.Tags)
+	assert.False(t, e.HasTag("blues"))
+}
+
+func TestAmpBank(t *testing.T) {
+	b := NewAmpBank("Amp")
+	assert.True(t, b.Fixed())
+	assert.Equal(t, 5, b.Len())
+	assert.Equal(t, 2, b.SlotOf(sysex.CH2Region))
+
+	r, ok := b.Region(0)
+	assert.True(t, ok)
+	assert.Equal(t, r, AmpRegions[0])
+
+	assert.Equal(t, ErrFixedBank, b.Append(NewEntry(nil)))
+	_, err := b.Remove(0)
+	assert.Equal(t, ErrFixedBank, err)
+	assert.Equal(t, ErrSlotIndex, b.Set(5, nil))
+}
+
+func TestCollectionOps(t *testing.T) {
+	b := NewCollection("Set list")
+	assert.False(t, b.Fixed())
+	for _, n := range []string{"A", "B", "C", "D"} {
+		assert.Nil(t, b.Append(namedEntry(t, n)))
+	}
+
+	assert.Nil(t, b.Move(0, 2))
+	assert.Equal(t, []string{"B", "C", "A", "D"}, names(b))
+
+	assert.Nil(t, b.Move(3, 0))
+	assert.Equal(t, []string{"D", "B", "C", "A"}, names(b))
+
+	assert.Nil(t, b.Swap(1, 3))
+	assert.Equal(t, []string{"D", "A", "C", "B"}, names(b))
+
+	e, err := b.Remove(2)
+	assert.Nil(t, err)
+	assert.Equal(t, "C", e.Name())
+	assert.Equal(t, []string{"D", "A", "B"}, names(b))
+
+	assert.Nil(t, b.Insert(0, e))
+	assert.Equal(t, []string{"C", "D", "A", "B"}, names(b))
+
+	assert.Equal(t, ErrSlotIndex, b.Move(0, 4))
+	assert.Equal(t, ErrSlotIndex, b.Insert(6, e))
+}
+
+func TestCopy(t *testing.T) {
+	src := NewCollection("Src")
+	assert.Nil(t, src.Append(namedEntry(t, "Clean")))
+	src.Entries[0].AddTag("jazz")
+
+	dst := NewAmpBank("Amp")
+	assert.Nil(t, src.CopyTo(0, dst, 1))
+	assert.Equal(t, "Clean", dst.Entries[1].Name())
+	assert.Equal(t, []string{"jazz"}, dst.Entries[1].Tags)
+
+	//The copy must not share memory with the original.
+	_, err := dst.Entries[1].Patch.WriteBytes(0, []byte("Dirty"))
+	assert.Nil(t, err)
+	dst.Entries[1].AddTag("rock")
+	assert.Equal(t, "Clean", src.Entries[0].Name())
+	assert.Equal(t, []string{"jazz"}, src.Entries[0].Tags)
+
+	assert.Equal(t, ErrEmptySlot, dst.Copy(0, 2))
+}
+
+func TestSaveLoad(t *testing.T) {
+	l := New()
+	amp := l.Bank("Amp")
+	assert.Nil(t, amp.Set(1, namedEntry(t, "Lead")))
+	amp.Entries[1].Category = "Solo"
+	amp.Entries[1].AddTag("loud")
+
+	c := NewCollection("Gig")
+	assert.Nil(t, c.Append(namedEntry(t, "Rhythm")))
+	l.Banks = append(l.Banks, c)
+
+	buf := bytes.Buffer{}
+	assert.Nil(t, l.Save(&buf))
+
+	r, err := Load(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(r.Banks))
+	assert.Equal(t, []string{"", "Lead", "", "", ""}, names(r.Bank("Amp")))
+	assert.Equal(t, []string{"Rhythm"}, names(r.Bank("Gig")))
+	assert.True(t, r.Bank("Amp").Fixed())
+	assert.False(t, r.Bank("Gig").Fixed())
+	assert.Equal(t, patch.Bytes(amp.Entries[1].Patch), patch.Bytes(r.Bank("Amp").Entries[1].Patch))
+
+	found := r.FindTag("loud")
+	assert.Equal(t, 1, len(found))
+	assert.Equal(t, "Lead", found[0].Name())
+	assert.Equal(t, 1, len(r.FindCategory("Solo")))
+
+	_, err = Load(bytes.NewBufferString(`{"version": 99}`))
+	assert.Equal(t, ErrUnknownVer, err)
+
+	_, err = Load(bytes.NewBufferString(`{"version": 1, "banks": [{"name": "Amp", "regions": [12288, 2049], "entries": [null]}]}`))
+	assert.Equal(t, ErrBankSlots, err)
+}
@@ -0,0 +1,125 @@
+package library
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/patch"
+)
+
+const (
+	fileVersion = 1
+)
+
+//On disk representation of a library.
+type fileLibrary struct {
+	Version int        `json:"version"`
+	Banks   []fileBank `json:"banks"`
+}
+
+type fileBank struct {
+	Name    string          `json:"name"`
+	Regions []libktn.Uint14 `json:"regions,omitempty"`
+	Entries []*fileEntry    `json:"entries"`
+}
+
+type fileEntry struct {
+	Category string   `json:"category,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Encoding uint16   `json:"encoding"`
+	Data     []byte   `json:"data"`
+}
+
+//Writes the library as JSON.
+//Patches are stored as their dense patch memory.
+func (l *Library) Save(w io.Writer) error {
+	f := fileLibrary{Version: fileVersion, Banks: make([]fileBank, len(l.Banks))}
+	for i, b := range l.Banks {
+		fb := fileBank{Name: b.Name, Regions: b.Regions, Entries: make([]*fileEntry, len(b.Entries))}
+		for j, e := range b.Entries {
+			if e == nil {
+				continue
+			}
+
+			fe := &fileEntry{Category: e.Category, Tags: e.Tags, Encoding: patch.EncSparse}
+			if e.Patch != nil {
+				fe.Encoding = e.Patch.Encoding()
+				fe.Data = patch.Bytes(e.Patch)
+			}
+			fb.Entries[j] = fe
+		}
+		f.Banks[i] = fb
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(f)
+}
+
+//Reads a library previously written with Save.
+func Load(r io.Reader) (*Library, error) {
+	f := fileLibrary{}
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, err
+	}
+
+	if f.Version != fileVersion {
+		return nil, ErrUnknownVer
+	}
+
+	l := &Library{Banks: make([]*Bank, len(f.Banks))}
+	for i, fb := range f.Banks {
+		if fb.Regions != nil && len(fb.Regions) != len(fb.Entries) {
+			return nil, ErrBankSlots
+		}
+		b := &Bank{Name: fb.Name, Regions: fb.Regions, Entries: make([]*Entry, len(fb.Entries))}
+		for j, fe := range fb.Entries {
+			if fe == nil {
+				continue
+			}
+
+			e := &Entry{Category: fe.Category, Tags: fe.Tags}
+			if fe.Data != nil {
+				p, err := patch.New(fe.Encoding)
+				if err != nil {
+					return nil, err
+				}
+				if _, err := p.WriteBytes(0, fe.Data); err != nil {
+					return nil, err
+				}
+				e.Patch = p
+			}
+			b.Entries[j] = e
+		}
+		l.Banks[i] = b
+	}
+
+	return l, nil
+}
+
+//Writes the library to a file, replacing it if it exists.
+func (l *Library) SaveFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := l.Save(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+//Reads a library from a file.
+func LoadFile(path string) (*Library, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
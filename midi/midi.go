@@ -0,0 +1,149 @@
+/*
+Package midi encodes and decodes the channel voice messages a Katana uses,
+program changes to select channels and control changes for switches and pedals.
+Sysex messages are handled by the sysex package.
+
+There is no table of the controllers the Katana responds to or of the programs that select
+its channels, as no verified one is available. Controller and program numbers are kept plain,
+so this package is a generic codec until such a table can be added.
+*/
+package midi
+
+import (
+	"errors"
+	"fmt"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+var (
+	ErrUnsupported = errors.New("MIDI message type is not supported.")
+	ErrBadLength   = errors.New("MIDI message length doesn't match its type.")
+	ErrBadChannel  = errors.New("MIDI channel should be 0-15.")
+	ErrBadData     = errors.New("MIDI data bytes should be 0x00-0x7F.")
+)
+
+//Message types, the high nibble of the status byte.
+const (
+	TypeControlChange = byte(0xB0)
+	TypeProgramChange = byte(0xC0)
+
+	typeMask    = byte(0xF0)
+	channelMask = byte(0x0F)
+	statusMask  = byte(0x80)
+	systemType  = byte(0xF0)
+)
+
+//Control change numbers of bank select.
+const (
+	CCBankMSB = libktn.Uint7(0)
+	CCBankLSB = libktn.Uint7(32)
+)
+
+//A channel voice message.
+type Message struct {
+	Type byte
+	//Zero based, so channel 1 in the amp's settings is 0.
+	Channel byte
+	//Controller and value of control changes, the program of program changes.
+	Data1, Data2 libktn.Uint7
+}
+
+//Number of data bytes following a channel voice status byte, or -1 for other bytes.
+func DataLen(status byte) int {
+	if status&statusMask == 0 || status&typeMask == systemType {
+		return -1
+	}
+
+	switch status & typeMask {
+	case TypeProgramChange, 0xD0:
+		return 1
+	default:
+		return 2
+	}
+}
+
+//Creates a Message from its bytes, including the status byte.
+func Parse(b []byte) (*Message, error) {
+	if len(b) == 0 {
+		return nil, ErrBadLength
+	}
+
+	n := DataLen(b[0])
+	if n < 0 {
+		return nil, ErrUnsupported
+	}
+	if len(b) != n+1 {
+		return nil, ErrBadLength
+	}
+
+	m := &Message{Type: b[0] & typeMask, Channel: b[0] & channelMask}
+	if m.Type != TypeControlChange && m.Type != TypeProgramChange {
+		return nil, ErrUnsupported
+	}
+
+	d1, err := libktn.MakeUint7(b[1])
+	if err != nil {
+		return nil, ErrBadData
+	}
+	m.Data1 = d1
+
+	if n == 2 {
+		d2, err := libktn.MakeUint7(b[2])
+		if err != nil {
+			return nil, ErrBadData
+		}
+		m.Data2 = d2
+	}
+	return m, nil
+}
+
+//Serializes a Message to bytes, including the status byte.
+func (m *Message) Bytes() ([]byte, error) {
+	if m.Type != TypeControlChange && m.Type != TypeProgramChange {
+		return nil, ErrUnsupported
+	}
+	if m.Channel > channelMask {
+		return nil, ErrBadChannel
+	}
+	if m.Data1 > 0x7F || m.Data2 > 0x7F {
+		return nil, ErrBadData
+	}
+
+	b := []byte{m.Type | m.Channel, byte(m.Data1)}
+	if m.Type == TypeControlChange {
+		b = append(b, byte(m.Data2))
+	}
+	return b, nil
+}
+
+//Factory for a program change.
+func MakeProgramChange(channel byte, program libktn.Uint7) Message {
+	return Message{Type: TypeProgramChange, Channel: channel, Data1: program}
+}
+
+//Factory for a control change.
+func MakeControlChange(channel byte, cc, value libktn.Uint7) Message {
+	return Message{Type: TypeControlChange, Channel: channel, Data1: cc, Data2: value}
+}
+
+//Factory for a bank select, which applies to the next program change.
+func MakeBankSelect(channel byte, bank libktn.Uint14) []Message {
+	return []Message{
+		MakeControlChange(channel, CCBankMSB, libktn.Uint7(bank>>7)),
+		MakeControlChange(channel, CCBankLSB, libktn.Uint7(bank&0x7F)),
+	}
+}
+
+func (m Message) String() string {
+	switch m.Type {
+	case TypeProgramChange:
+		return fmt.Sprintf("PC ch=%d program=%d", m.Channel+1, m.Data1)
+
+	case TypeControlChange:
+		return fmt.Sprintf("CC ch=%d cc=%d value=%d", m.Channel+1, m.Data1, m.Data2)
+
+	default:
+		return fmt.Sprintf("Unsupported type 0x%02X ch=%d", m.Type, m.Channel+1)
+	}
+}
@@ -0,0 +1,62 @@
+package midi
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+func TestParse(t *testing.T) {
+	valid := map[string]Message{
+		"\xC0\x01":     MakeProgramChange(0, 1),
+		"\xC3\x04":     MakeProgramChange(3, 4),
+		"\xB0\x10\x7F": MakeControlChange(0, 16, 127),
+		"\xBF\x07\x40": MakeControlChange(15, 7, 64),
+	}
+	for in, exp := range valid {
+		m, err := Parse([]byte(in))
+		assert.Nil(t, err)
+		assert.Equal(t, exp, *m)
+
+		b, err := m.Bytes()
+		assert.Nil(t, err)
+		assert.Equal(t, in, string(b))
+	}
+
+	invalid := map[string]error{
+		"":             ErrBadLength,
+		"\x10":         ErrUnsupported,
+		"\xF0\x01":     ErrUnsupported,
+		"\x90\x40\x7F": ErrUnsupported,
+		"\xC0":         ErrBadLength,
+		"\xB0\x10":     ErrBadLength,
+		"\xB0\x10\x80": ErrBadData,
+	}
+	for in, exp := range invalid {
+		m, err := Parse([]byte(in))
+		assert.Nil(t, m)
+		assert.Equal(t, exp, err)
+	}
+}
+
+func TestBytes(t *testing.T) {
+	m := MakeProgramChange(16, 0)
+	_, err := m.Bytes()
+	assert.Equal(t, ErrBadChannel, err)
+
+	m = MakeControlChange(0, 0x80, 0)
+	_, err = m.Bytes()
+	assert.Equal(t, ErrBadData, err)
+
+	bs := MakeBankSelect(1, 130)
+	assert.Equal(t, 2, len(bs))
+	assert.Equal(t, MakeControlChange(1, CCBankMSB, 1), bs[0])
+	assert.Equal(t, MakeControlChange(1, CCBankLSB, 2), bs[1])
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "PC ch=1 program=4", MakeProgramChange(0, 4).String())
+	assert.Equal(t, "PC ch=2 program=9", MakeProgramChange(1, 9).String())
+	assert.Equal(t, "CC ch=1 cc=16 value=64", MakeControlChange(0, 16, 64).String())
+	assert.Equal(t, "CC ch=1 cc=90 value=1", MakeControlChange(0, 90, 1).String())
+}
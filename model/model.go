@@ -0,0 +1,221 @@
+package model
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+var (
+	ErrUnknownFirmware = errors.New("No known Katana variant matches this firmware version")
+	ErrUnknownFamily   = errors.New("Device family code is not a Katana")
+)
+
+//Identification bytes shared by every Katana variant.
+var (
+	ModelId    = []byte{0x00, 0x00, 0x00, 0x33}
+	FamilyCode = []byte{0x33, 0x03, 0x00, 0x00}
+)
+
+//Hardware generation of the amp.
+type Generation int
+
+//The MkII will be added once its ID response and parameter map are known.
+const (
+	MkI Generation = 1
+)
+
+func (g Generation) String() string {
+	switch g {
+	case MkI:
+		return "MkI"
+	default:
+		return fmt.Sprintf("Generation(%d)", int(g))
+	}
+}
+
+//Cabinet or head variant of the amp.
+//These all identify the same over MIDI, so it has to be provided by the user.
+type Size int
+
+const (
+	SizeUnknown Size = iota
+	Size50
+	Size100
+	SizeHead
+	SizeArtist
+)
+
+func (s Size) String() string {
+	switch s {
+	case Size50:
+		return "Katana-50"
+	case Size100:
+		return "Katana-100"
+	case SizeHead:
+		return "Katana-Head"
+	case SizeArtist:
+		return "Katana-Artist"
+	default:
+		return "Katana"
+	}
+}
+
+//Flags for functionality that only some variants support.
+type Feature uint32
+
+const (
+	//The fx1/fx2 ac sim, rotary 2, tera echo and overtone types.
+	FeatExtendedFx Feature = 1 << iota
+	//The chain_ptn preset FX chain parameter.
+	FeatChainPattern
+	//Colour assignments for the booster, mod and FX knobs.
+	FeatFxBox
+)
+
+//A range of patch offsets, end is exclusive.
+type Range struct {
+	Begin, End libktn.Uint14
+}
+
+//Describes what a Katana variant supports.
+type Capability struct {
+	Name       string
+	Generation Generation
+
+	//Identification, firmware bounds are inclusive.
+	ModelId     []byte
+	FamilyCode  []byte
+	FirmwareMin []byte
+	FirmwareMax []byte
+
+	//Patch offsets the variant uses, in every patch region of sysex.
+	Ranges []Range
+
+	Features Feature
+}
+
+//Tests whether all of the given features are supported.
+func (c *Capability) Has(f Feature) bool {
+	return c.Features&f == f
+}
+
+//Tests whether the firmware version falls within this variant's bounds.
+//Variants without bounds never match, they can't be detected.
+func (c *Capability) MatchFirmware(firmware []byte) bool {
+	if c.FirmwareMin == nil || c.FirmwareMax == nil {
+		return false
+	}
+	return bytes.Compare(firmware, c.FirmwareMin) >= 0 && bytes.Compare(firmware, c.FirmwareMax) <= 0
+}
+
+//Tests whether a patch offset is used by this variant.
+func (c *Capability) ValidOffset(offset libktn.Uint14) bool {
+	for _, r := range c.Ranges {
+		if offset >= r.Begin && offset < r.End {
+			return true
+		}
+	}
+	return false
+}
+
+//An amp the user owns, combining the size they told us with what the amp identifies as.
+type Amp struct {
+	Size       Size
+	Capability *Capability
+}
+
+var (
+	rangesV1 = []Range{
+		Range{Begin: 0, End: 107},
+		Range{Begin: 192, End: 1059},
+	}
+	rangesV2 = []Range{
+		Range{Begin: 0, End: 107},
+		Range{Begin: 192, End: 1059},
+		Range{Begin: 2064, End: 2107},
+		Range{Begin: 2304, End: 2327},
+	}
+)
+
+//Built in variants.
+var (
+	KatanaV1 = &Capability{
+		Name:        "Katana v1.x",
+		Generation:  MkI,
+		ModelId:     ModelId,
+		FamilyCode:  FamilyCode,
+		FirmwareMin: []byte{0x01, 0x00, 0x00, 0x00},
+		FirmwareMax: []byte{0x01, 0x7F, 0x7F, 0x7F},
+		Ranges:      rangesV1,
+	}
+	KatanaV2 = &Capability{
+		Name:        "Katana v2.x",
+		Generation:  MkI,
+		ModelId:     ModelId,
+		FamilyCode:  FamilyCode,
+		FirmwareMin: []byte{0x02, 0x00, 0x00, 0x00},
+		FirmwareMax: []byte{0x02, 0x7F, 0x7F, 0x7F},
+		Ranges:      rangesV2,
+		Features:    FeatExtendedFx | FeatChainPattern | FeatFxBox,
+	}
+	KatanaV3 = &Capability{
+		Name:        "Katana v3.x",
+		Generation:  MkI,
+		ModelId:     ModelId,
+		FamilyCode:  FamilyCode,
+		FirmwareMin: []byte{0x03, 0x00, 0x00, 0x00},
+		FirmwareMax: []byte{0x03, 0x7F, 0x7F, 0x7F},
+		Ranges:      rangesV2,
+		Features:    FeatExtendedFx | FeatChainPattern | FeatFxBox,
+	}
+)
+
+//Only variants whose ID response is known are listed, the MkII will be added once one is captured.
+var registry = []*Capability{KatanaV1, KatanaV2, KatanaV3}
+
+//Adds a variant to the registry.
+//Variants registered later take precedence when firmware bounds overlap.
+func Register(c *Capability) {
+	registry = append(registry, c)
+}
+
+//Lists all known variants.
+func All() []*Capability {
+	r := make([]*Capability, len(registry))
+	copy(r, registry)
+	return r
+}
+
+//Finds the variant matching the identification of an ID response.
+func Lookup(family, firmware []byte) (*Capability, error) {
+	known := false
+	for i := len(registry) - 1; i >= 0; i-- {
+		c := registry[i]
+		if !bytes.Equal(family, c.FamilyCode) {
+			continue
+		}
+
+		known = true
+		if c.MatchFirmware(firmware) {
+			return c, nil
+		}
+	}
+
+	if !known {
+		return nil, ErrUnknownFamily
+	}
+	return nil, ErrUnknownFirmware
+}
+
+//Tests whether the model ID belongs to any registered variant.
+func KnownModelId(id []byte) bool {
+	for _, c := range registry {
+		if bytes.Equal(id, c.ModelId) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+func TestLookup(t *testing.T) {
+	var (
+		valid = map[[4]byte]*Capability{
+			[4]byte{0x01, 0x00, 0x00, 0x00}: KatanaV1,
+			[4]byte{0x01, 0x02, 0x00, 0x00}: KatanaV1,
+			[4]byte{0x02, 0x00, 0x00, 0x00}: KatanaV2,
+			[4]byte{0x03, 0x01, 0x00, 0x00}: KatanaV3,
+		}
+	)
+
+	for in, exp := range valid {
+		c, e := Lookup(FamilyCode, in[:])
+		assert.Nil(t, e)
+		assert.Equal(t, exp, c)
+	}
+
+	c, e := Lookup(FamilyCode, []byte{0x10, 0x00, 0x00, 0x00})
+	assert.Equal(t, ErrUnknownFirmware, e)
+	assert.Nil(t, c)
+
+	//Variants without firmware bounds can't be detected.
+	assert.False(t, (&Capability{FamilyCode: FamilyCode}).MatchFirmware([]byte{0x04, 0x00, 0x00, 0x00}))
+
+	c, e = Lookup([]byte{0x01, 0x02, 0x03, 0x04}, []byte{0x01, 0x00, 0x00, 0x00})
+	assert.Equal(t, ErrUnknownFamily, e)
+	assert.Nil(t, c)
+}
+
+func TestCapability(t *testing.T) {
+	assert.False(t, KatanaV1.Has(FeatFxBox))
+	assert.True(t, KatanaV2.Has(FeatFxBox|FeatChainPattern))
+	assert.False(t, KatanaV1.Has(FeatFxBox|FeatChainPattern))
+
+	assert.True(t, KatanaV1.ValidOffset(928))
+	assert.False(t, KatanaV1.ValidOffset(2304))
+	assert.True(t, KatanaV2.ValidOffset(2304))
+	assert.False(t, KatanaV2.ValidOffset(107))
+
+	assert.True(t, KnownModelId([]byte{0x00, 0x00, 0x00, 0x33}))
+	assert.False(t, KnownModelId([]byte{0x00, 0x00, 0x00, 0x34}))
+}
+
+func TestRegister(t *testing.T) {
+	saved := registry
+	defer func() { registry = saved }()
+
+	beta := &Capability{
+		Name:        "Katana v3 beta",
+		Generation:  MkI,
+		ModelId:     ModelId,
+		FamilyCode:  FamilyCode,
+		FirmwareMin: []byte{0x03, 0x7F, 0x00, 0x00},
+		FirmwareMax: []byte{0x03, 0x7F, 0x7F, 0x7F},
+	}
+	Register(beta)
+
+	c, e := Lookup(FamilyCode, []byte{0x03, 0x7F, 0x01, 0x00})
+	assert.Nil(t, e)
+	assert.Equal(t, beta, c)
+
+	c, e = Lookup(FamilyCode, []byte{0x03, 0x00, 0x00, 0x00})
+	assert.Nil(t, e)
+	assert.Equal(t, KatanaV3, c)
+}
@@ -0,0 +1,157 @@
+package patch
+
+import (
+	"fmt"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+const (
+	NumAssigns = 8
+
+	offAssign    = 1072 //08 30
+	lenAssign    = 16
+	strideAssign = 32
+)
+
+var ErrAssignIndex = fmt.Errorf("Assign number should be between 1 and %d", NumAssigns)
+
+//One of the assign1..8 blocks, mapping a controller to a parameter.
+//Target is the raw assign*_target value.
+type Assign struct {
+	On         bool
+	Target     libktn.Uint14
+	TargetMin  libktn.Uint14
+	TargetMax  libktn.Uint14
+	Source     libktn.Uint7
+	SourceMode libktn.Uint7
+	ActRangeLo libktn.Uint7
+	ActRangeHi libktn.Uint7
+
+	//Internal pedal settings.
+	IntPdlTrigger libktn.Uint7
+	IntPdlTime    libktn.Uint7
+	IntPdlCurve   libktn.Uint7
+
+	//Wave pedal settings.
+	WaveRate libktn.Uint7
+	Waveform libktn.Uint7
+}
+
+func assignOffset(n int) (libktn.Uint14, error) {
+	if n < 1 || n > NumAssigns {
+		return 0, ErrAssignIndex
+	}
+	return libktn.Uint14(offAssign + (n-1)*strideAssign), nil
+}
+
+//Reads assign n (1 to 8) from a patch.
+//Sparse encodings discard assigns, so this needs a lossless patch.
+func GetAssign(p Patch, n int) (Assign, error) {
+	o, err := assignOffset(n)
+	if err != nil {
+		return Assign{}, err
+	}
+
+	b := make([]byte, lenAssign)
+	for i := range b {
+		v, err := p.GetByte(o + libktn.Uint14(i))
+		if err != nil {
+			return Assign{}, err
+		}
+		b[i] = byte(v)
+	}
+
+	return DecodeAssign(b)
+}
+
+//Writes assign n (1 to 8) to a patch.
+//Like GetAssign this needs a lossless patch, sparse encodings give ErrDiscardedOffset.
+func SetAssign(p Patch, n int, a Assign) (WriteStat, error) {
+	o, err := assignOffset(n)
+	if err != nil {
+		return WriteStat{}, err
+	}
+	if _, err := p.GetByte(o); err != nil {
+		return WriteStat{}, err
+	}
+
+	b, err := a.Encode()
+	if err != nil {
+		return WriteStat{}, err
+	}
+	return p.WriteBytes(o, b)
+}
+
+//Creates an Assign from the 16 bytes of an assign block.
+//The on/off switch has to be 0 or 1, other values give ErrSwitchValue.
+func DecodeAssign(b []byte) (Assign, error) {
+	if len(b) != lenAssign {
+		return Assign{}, libktn.SliceLengthError{lenAssign}
+	}
+
+	var shorts [3]libktn.Uint14
+	for i := range shorts {
+		v, err := libktn.MakeUint14(b[1+i*2 : 3+i*2])
+		if err != nil {
+			return Assign{}, err
+		}
+		shorts[i] = v
+	}
+
+	for _, v := range b {
+		if _, err := libktn.MakeUint7(v); err != nil {
+			return Assign{}, err
+		}
+	}
+	if b[0] > 1 {
+		return Assign{}, ErrSwitchValue
+	}
+
+	return Assign{
+		On:            b[0] != 0,
+		Target:        shorts[0],
+		TargetMin:     shorts[1],
+		TargetMax:     shorts[2],
+		Source:        libktn.Uint7(b[7]),
+		SourceMode:    libktn.Uint7(b[8]),
+		ActRangeLo:    libktn.Uint7(b[9]),
+		ActRangeHi:    libktn.Uint7(b[10]),
+		IntPdlTrigger: libktn.Uint7(b[11]),
+		IntPdlTime:    libktn.Uint7(b[12]),
+		IntPdlCurve:   libktn.Uint7(b[13]),
+		WaveRate:      libktn.Uint7(b[14]),
+		Waveform:      libktn.Uint7(b[15]),
+	}, nil
+}
+
+//Converts to the 16 bytes of an assign block.
+func (a Assign) Encode() ([]byte, error) {
+	b := make([]byte, 0, lenAssign)
+	if a.On {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+
+	for _, v := range []libktn.Uint14{a.Target, a.TargetMin, a.TargetMax} {
+		s, err := v.Sysex()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, s...)
+	}
+
+	for _, v := range []libktn.Uint7{
+		a.Source, a.SourceMode, a.ActRangeLo, a.ActRangeHi,
+		a.IntPdlTrigger, a.IntPdlTime, a.IntPdlCurve, a.WaveRate, a.Waveform,
+	} {
+		s, err := v.Sysex()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, s...)
+	}
+
+	return b, nil
+}
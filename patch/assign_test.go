@@ -0,0 +1,108 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+func TestAssignRoundTrip(t *testing.T) {
+	a := Assign{
+		On:         true,
+		Target:     742,
+		TargetMin:  0,
+		TargetMax:  100,
+		Source:     3,
+		SourceMode: 1,
+		ActRangeLo: 10,
+		ActRangeHi: 117,
+		Waveform:   2,
+		WaveRate:   40,
+	}
+
+	p := NewDense()
+	s, err := SetAssign(p, 3, a)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(lenAssign), s.Written())
+
+	//Check a few bytes land on the TSL map offsets.
+	src, _ := SchemaAll.Param("assign3_source")
+	v, _ := Get(p, src)
+	assert.Equal(t, libktn.Uint14(3), v)
+	max, _ := SchemaAll.Param("assign3_target_max")
+	v, _ = Get(p, max)
+	assert.Equal(t, libktn.Uint14(100), v)
+
+	r, err := GetAssign(p, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, a, r)
+
+	r, err = GetAssign(p, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, Assign{}, r)
+}
+
+func TestAssignErrors(t *testing.T) {
+	_, err := GetAssign(NewDense(), 0)
+	assert.Equal(t, ErrAssignIndex, err)
+	_, err = SetAssign(NewDense(), 9, Assign{})
+	assert.Equal(t, ErrAssignIndex, err)
+
+	//Sparse patches don't keep assigns.
+	_, err = GetAssign(NewSparse(), 1)
+	assert.Equal(t, ErrDiscardedOffset, err)
+	p := NewSparse()
+	_, err = SetAssign(p, 1, Assign{On: true})
+	assert.Equal(t, ErrDiscardedOffset, err)
+	assert.True(t, Equal(NewSparse(), p))
+
+	//Switch values other than 0 and 1 would be lost as a bool.
+	b, err := Assign{On: true}.Encode()
+	assert.Nil(t, err)
+	b[0] = 2
+	_, err = DecodeAssign(b)
+	assert.Equal(t, ErrSwitchValue, err)
+
+	_, err = Assign{Source: 0x80}.Encode()
+	assert.Equal(t, libktn.ErrOutOfBounds, err)
+
+	_, err = DecodeAssign(make([]byte, 3))
+	assert.Equal(t, libktn.SliceLengthError{lenAssign}, err)
+}
+
+func TestDenseLossless(t *testing.T) {
+	p, err := New(EncDense)
+	assert.Nil(t, err)
+
+	b := make([]byte, lenPatch)
+	for i := range b {
+		b[i] = byte(i % 0x80)
+	}
+	s, err := p.WriteBytes(0, b)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(lenPatch), s.Written())
+	assert.Equal(t, b, Bytes(p))
+
+	s, err = p.WriteBytes(offMax, []byte{1, 2, 3})
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(1), s.Written())
+	assert.Equal(t, libktn.Uint14(2), s.Discarded())
+
+	_, err = p.GetShort(offMax)
+	assert.Equal(t, libktn.ErrOutOfBounds, err)
+
+	//Going to a sparse encoding reports the assigns as lost.
+	_, err = SetAssign(p, 1, Assign{On: true})
+	assert.Nil(t, err)
+	_, r, err := Convert(p, EncSparse)
+	assert.Nil(t, err)
+	found := false
+	for _, n := range r.Lost {
+		if n == "assign1_on_off" {
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
@@ -0,0 +1,127 @@
+package patch
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/model"
+)
+
+/*
+Binary patches have a 12 byte header followed by the encoding's own memory layout.
+
+	0  "KTNP"
+	4  version
+	5  encoding, big endian uint16
+	7  generation of the parameter map, 0 when it covers all of them
+	8  CRC-32 (IEEE) of the data, big endian
+	12 data
+*/
+const (
+	binaryMagic   = "KTNP"
+	binaryVersion = 1
+	lenBinaryHead = 12
+)
+
+var (
+	ErrBinaryMagic    = errors.New("Data is not a binary patch")
+	ErrBinaryVersion  = errors.New("Unknown binary patch version")
+	ErrBinaryChecksum = errors.New("Binary patch checksum doesn't match")
+	ErrBinaryModel    = errors.New("Binary patch generation doesn't match its encoding")
+	ErrBinaryEncoding = errors.New("Binary patch encoding doesn't match the patch")
+)
+
+func (p *SparsePatch) MarshalBinary() ([]byte, error) {
+	return encodeBinary(EncSparse, p.data), nil
+}
+
+func (p *SparsePatch) UnmarshalBinary(b []byte) error {
+	n, err := decodeBinary(b)
+	if err != nil {
+		return err
+	}
+
+	sp, ok := n.(*SparsePatch)
+	if !ok {
+		return ErrBinaryEncoding
+	}
+	*p = *sp
+	return nil
+}
+
+func (p *DensePatch) MarshalBinary() ([]byte, error) {
+	return encodeBinary(EncDense, p.data), nil
+}
+
+func (p *DensePatch) UnmarshalBinary(b []byte) error {
+	n, err := decodeBinary(b)
+	if err != nil {
+		return err
+	}
+
+	dp, ok := n.(*DensePatch)
+	if !ok {
+		return ErrBinaryEncoding
+	}
+	*p = *dp
+	return nil
+}
+
+//Reads a binary patch, using whichever encoding it was stored with.
+func UnmarshalBinary(b []byte) (Patch, error) {
+	return decodeBinary(b)
+}
+
+func encodeBinary(enc uint16, data []byte) []byte {
+	b := make([]byte, lenBinaryHead, lenBinaryHead+len(data))
+	copy(b, binaryMagic)
+	b[4] = binaryVersion
+	binary.BigEndian.PutUint16(b[5:], enc)
+	b[7] = byte(SchemaOf(enc).Generation)
+	binary.BigEndian.PutUint32(b[8:], crc32.ChecksumIEEE(data))
+	return append(b, data...)
+}
+
+func decodeBinary(b []byte) (Patch, error) {
+	if len(b) < lenBinaryHead || string(b[:4]) != binaryMagic {
+		return nil, ErrBinaryMagic
+	}
+	if b[4] != binaryVersion {
+		return nil, ErrBinaryVersion
+	}
+
+	enc := binary.BigEndian.Uint16(b[5:])
+	p, err := New(enc)
+	if err != nil {
+		return nil, err
+	}
+	if model.Generation(b[7]) != SchemaOf(enc).Generation {
+		return nil, ErrBinaryModel
+	}
+
+	data := b[lenBinaryHead:]
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(b[8:]) {
+		return nil, ErrBinaryChecksum
+	}
+
+	switch np := p.(type) {
+	case *SparsePatch:
+		err = copyData(np.data, data)
+	case *DensePatch:
+		err = copyData(np.data, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func copyData(dst, src []byte) error {
+	if len(dst) != len(src) {
+		return libktn.SliceLengthError{len(dst)}
+	}
+	copy(dst, src)
+	return nil
+}
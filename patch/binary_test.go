@@ -0,0 +1,61 @@
+package patch
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, p := range []Patch{NewSparse(), NewDense()} {
+		_, err := SetName(p, "Stored")
+		assert.Nil(t, err)
+
+		b, err := p.(encoding.BinaryMarshaler).MarshalBinary()
+		assert.Nil(t, err)
+		assert.Equal(t, "KTNP", string(b[:4]))
+
+		r, err := UnmarshalBinary(b)
+		assert.Nil(t, err)
+		assert.Equal(t, p.Encoding(), r.Encoding())
+		assert.True(t, Equal(p, r))
+
+		//Unmarshal into an existing patch as well.
+		c, err := New(p.Encoding())
+		assert.Nil(t, err)
+		assert.Nil(t, c.(encoding.BinaryUnmarshaler).UnmarshalBinary(b))
+		assert.Equal(t, Hash(p), Hash(c))
+	}
+}
+
+func TestBinaryErrors(t *testing.T) {
+	b, err := NewSparse().(*SparsePatch).MarshalBinary()
+	assert.Nil(t, err)
+
+	_, err = UnmarshalBinary(b[:4])
+	assert.Equal(t, ErrBinaryMagic, err)
+
+	bad := append([]byte{}, b...)
+	bad[4] = 9
+	_, err = UnmarshalBinary(bad)
+	assert.Equal(t, ErrBinaryVersion, err)
+
+	bad = append([]byte{}, b...)
+	bad[7] = 2
+	_, err = UnmarshalBinary(bad)
+	assert.Equal(t, ErrBinaryModel, err)
+
+	bad = append([]byte{}, b...)
+	bad[20] = 1
+	_, err = UnmarshalBinary(bad)
+	assert.Equal(t, ErrBinaryChecksum, err)
+
+	//A dense patch doesn't fit a sparse one.
+	d, err := NewDense().(*DensePatch).MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, ErrBinaryEncoding, NewSparse().(*SparsePatch).UnmarshalBinary(d))
+	s, err := NewSparse().(*SparsePatch).MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, ErrBinaryEncoding, NewDense().(*DensePatch).UnmarshalBinary(s))
+}
@@ -0,0 +1,40 @@
+package patch
+
+//Describes what changed when converting a patch between encodings.
+type Report struct {
+	//Parameters with a non-default value the target doesn't support.
+	Lost []string
+}
+
+//Converts a patch to another encoding.
+//Parameters are copied by name, the report lists which could not be copied as-is.
+func Convert(p Patch, enc uint16) (Patch, Report, error) {
+	r := Report{}
+	dst, err := New(enc)
+	if err != nil {
+		return nil, r, err
+	}
+
+	from := SchemaOf(p.Encoding())
+	to := SchemaOf(enc)
+
+	//Start with a straight copy, the encoding discards what it doesn't keep.
+	if _, err := dst.WriteBytes(0, Bytes(p)); err != nil {
+		return nil, r, err
+	}
+
+	for _, sp := range from.Supported() {
+		tp, ok := to.Param(sp.Name)
+		if ok && tp.Supported {
+			continue
+		}
+
+		v, err := Get(p, sp)
+		if err != nil || v == 0 {
+			continue
+		}
+		r.Lost = append(r.Lost, sp.Name)
+	}
+
+	return dst, r, nil
+}
@@ -0,0 +1,78 @@
+package patch
+
+import (
+	"fmt"
+	"strings"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+//Up to this many parameters are described with their values.
+const describeValues = 4
+
+func init() {
+	sysex.RegisterNamer(nameMemory)
+}
+
+//Names the parameters a message covers in a patch region, with their values
+//when a command covers a few of them whole.
+func nameMemory(a sysex.Address, size int, data []byte) string {
+	if !sysex.MutablePatchRegions[a.Region] || size < 1 {
+		return ""
+	}
+
+	var params []Param
+	for _, p := range SchemaAll.Params {
+		if int(p.Offset+p.Size) > int(a.Offset) && int(p.Offset) < int(a.Offset)+size {
+			params = append(params, p)
+		}
+	}
+	if len(params) == 0 {
+		return ""
+	}
+
+	if data != nil && len(params) <= describeValues {
+		var vals []string
+		for _, p := range params {
+			o := int(p.Offset) - int(a.Offset)
+			if o < 0 || o+int(p.Size) > len(data) {
+				vals = nil
+				break
+			}
+
+			v, err := paramValue(p, data[o:o+int(p.Size)])
+			if err != nil {
+				vals = nil
+				break
+			}
+			vals = append(vals, fmt.Sprintf("%s=%s", p.Name, formatValue(kindOf(p.Name), v)))
+		}
+		if vals != nil {
+			return strings.Join(vals, " ")
+		}
+	}
+
+	first, last := params[0].Name, params[len(params)-1].Name
+	if first == last {
+		return first
+	}
+	return nameRange(first, last)
+}
+
+func paramValue(p Param, b []byte) (libktn.Uint14, error) {
+	if p.Size == 2 {
+		return libktn.MakeUint14(b)
+	}
+	v, err := libktn.MakeUint7(b[0])
+	return libktn.Uint14(v), err
+}
+
+//Shortens numbered ranges, so patch_name1..patch_name16 becomes patch_name1..16.
+func nameRange(first, last string) string {
+	base := strings.TrimRight(first, "0123456789")
+	if base != first && strings.TrimRight(last, "0123456789") == base {
+		return first + ".." + last[len(base):]
+	}
+	return first + ".." + last
+}
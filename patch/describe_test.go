@@ -0,0 +1,28 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+func TestDescribeMessage(t *testing.T) {
+	q := sysex.MakeQuery(sysex.Address{Region: sysex.CH1Region, Offset: offName}, lenName)
+	assert.Equal(t, "RQ1 dev=00 addr=10 01 00 00 (CH1 patch_name1..16) size=16", q.String())
+
+	c := sysex.MakeCommand(sysex.Address{Region: sysex.CH2Region, Offset: offFxChain}, []byte{0x01, 0x02, 0x03, 0x04})
+	assert.Equal(t, "DT1 dev=00 addr=10 02 07 20 (CH2 fx_chain_position1=1 fx_chain_position2=2 fx_chain_position3=3 fx_chain_position4=4) data=[01 02 03 04]", c.String())
+
+	c = sysex.MakeCommand(sysex.Address{Region: sysex.CH2Region, Offset: offFxChain}, make([]byte, lenFxChain))
+	assert.Equal(t, "DT1 dev=00 addr=10 02 07 20 (CH2 fx_chain_position1..20) data=[00 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00]", c.String())
+
+	c = sysex.MakeCommand(sysex.Address{Region: sysex.CH4Region, Offset: offName}, []byte("A"))
+	assert.Equal(t, "DT1 dev=00 addr=10 04 00 00 (CH4 patch_name1=65) data=[41]", c.String())
+}
+
+func TestNameRange(t *testing.T) {
+	assert.Equal(t, "patch_name1..16", nameRange("patch_name1", "patch_name16"))
+	assert.Equal(t, "patch_name16..fx_chain_position1", nameRange("patch_name16", "fx_chain_position1"))
+}
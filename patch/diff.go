@@ -0,0 +1,49 @@
+package patch
+
+import (
+	"fmt"
+	"sort"
+)
+
+//Lists the differences between two patches, one line each.
+//Like Equal, parameters only one of the encodings keeps are skipped.
+func DiffText(a, b Text) []string {
+	var r []string
+	if a.Name != b.Name {
+		r = append(r, fmt.Sprintf("name: %q -> %q", a.Name, b.Name))
+	}
+	if fmt.Sprint(a.Chain) != fmt.Sprint(b.Chain) {
+		r = append(r, fmt.Sprintf("chain: %v -> %v", a.Chain, b.Chain))
+	}
+
+	fb := map[string]Value{}
+	for _, g := range b.Params {
+		for n, v := range g {
+			fb[n] = v
+		}
+	}
+
+	var lines []string
+	for _, g := range a.Params {
+		for n, va := range g {
+			if vb, ok := fb[n]; ok && va != vb {
+				lines = append(lines, fmt.Sprintf("%s: %s -> %s", n, va, vb))
+			}
+		}
+	}
+	sort.Strings(lines)
+	return append(r, lines...)
+}
+
+//Lists the differences between two patches, like DiffText.
+func Diff(a, b Patch) ([]string, error) {
+	ta, err := ToText(a)
+	if err != nil {
+		return nil, err
+	}
+	tb, err := ToText(b)
+	if err != nil {
+		return nil, err
+	}
+	return DiffText(ta, tb), nil
+}
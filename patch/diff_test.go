@@ -0,0 +1,33 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+func TestDiffText(t *testing.T) {
+	a := Text{Name: "A", Params: map[string]map[string]Value{
+		"booster": {"od_ds_drive": "10", "od_ds_tone": "5"},
+		"assign1": {"assign1_on_off": "true"},
+	}}
+	b := Text{Name: "A", Params: map[string]map[string]Value{
+		"booster": {"od_ds_drive": "20", "od_ds_tone": "5"},
+	}}
+	assert.Equal(t, []string{"od_ds_drive: 10 -> 20"}, DiffText(a, b))
+}
+
+func TestDiff(t *testing.T) {
+	a := NewDense()
+	b := a.Clone()
+	_, err := SetName(b, "Lead")
+	assert.Nil(t, err)
+
+	d, err := Diff(a, b)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`name: "" -> "Lead"`}, d)
+
+	d, err = Diff(a, a)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(d))
+}
@@ -0,0 +1,16 @@
+/*
+Package patch reads and writes Katana patches, the memory behind a channel or the panel.
+
+Parameters are known by their name, offset and size from the TSL map, see data/tsl-map.csv.
+The meaning of some values hasn't been checked against Boss Tone Studio or the amp,
+so this package keeps them as raw numbers instead of guessing:
+  - The block IDs of the FX chain. A chain is known to hold 0 to 19 once each, so
+    reordering the IDs of a valid chain is safe, while naming them is not.
+  - The od_ds_type, fx1_fx_type and fx2_fx_type values and the types they select.
+    Effect parameters are addressed by the type names in their TSL map names instead.
+  - The chain_ptn preset patterns and the block orders they select.
+  - The parameters assign*_target refers to.
+  - What box 3 of the FX boxes holds.
+  - The legal range of each parameter, beyond fitting its bytes.
+*/
+package patch
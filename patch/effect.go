@@ -0,0 +1,227 @@
+package patch
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+var (
+	ErrEffectType  = errors.New("Effect type is not available for this block")
+	ErrEffectParam = errors.New("Effect type has no parameter with this name")
+)
+
+//An effect block with a selectable type, such as the booster or the mod and FX blocks.
+//Parameters are addressed by their name without the block and type prefixes,
+//so fx1_phaser_rate and fx2_phaser_rate are both "rate" of the phaser type.
+//The mod and FX blocks have parameters per type, which are addressed with a name from Types.
+//Other blocks share their parameters between types and take an empty type name.
+type Effect struct {
+	Name string
+
+	//Parameters for switching the block and selecting its type.
+	on, typ string
+	//Type names with their own parameters, nil when the types share them.
+	types []string
+
+	//Finds the parameter name prefix for a type name, false if the type isn't valid.
+	prefix func(typ string) (string, bool)
+}
+
+var (
+	EffectBooster = simpleEffect("booster", "od_ds")
+	EffectMod     = fxEffect("mod", "fx1")
+	EffectFx      = fxEffect("fx", "fx2")
+	EffectDelay   = simpleEffect("delay", "delay")
+	EffectReverb  = simpleEffect("reverb", "reverb")
+
+	Effects = []*Effect{EffectBooster, EffectMod, EffectFx, EffectDelay, EffectReverb}
+)
+
+func fxEffect(name, slot string) *Effect {
+	var types []string
+	for _, t := range FxTypes {
+		if slot == "fx2" || !fx2Only(t) {
+			types = append(types, t)
+		}
+	}
+
+	return &Effect{
+		Name:  name,
+		on:    slot + "_on_off",
+		typ:   slot + "_fx_type",
+		types: types,
+		prefix: func(typ string) (string, bool) {
+			if fx2Only(typ) && slot == "fx2" {
+				//The newer fx2 only types have their own naming.
+				return "prm_" + slot + "_" + typ + "_", true
+			}
+			for _, t := range types {
+				if t == typ {
+					return slot + "_" + typ + "_", true
+				}
+			}
+			return "", false
+		},
+	}
+}
+
+func simpleEffect(name, prefix string) *Effect {
+	return &Effect{
+		Name: name,
+		on:   prefix + "_on_off",
+		typ:  prefix + "_type",
+		prefix: func(typ string) (string, bool) {
+			return prefix + "_", typ == ""
+		},
+	}
+}
+
+//Finds an effect block by name.
+func EffectByName(name string) (*Effect, bool) {
+	for _, e := range Effects {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+//Lists the type names with their own parameters, nil when all types share them.
+func (e *Effect) Types() []string {
+	return e.types
+}
+
+//Lists the parameter names available for a type of this block.
+func (e *Effect) Params(typ string) ([]string, error) {
+	pre, ok := e.prefix(typ)
+	if !ok {
+		return nil, ErrEffectType
+	}
+
+	var r []string
+	for _, p := range SchemaAll.Params {
+		if p.Name == e.on || p.Name == e.typ || !strings.HasPrefix(p.Name, pre) {
+			continue
+		}
+		r = append(r, strings.TrimPrefix(p.Name, pre))
+	}
+	sort.Strings(r)
+	return r, nil
+}
+
+//Finds the patch parameter behind a logical name for a type of this block.
+func (e *Effect) Param(p Patch, typ string, name string) (Param, error) {
+	pre, ok := e.prefix(typ)
+	if !ok {
+		return Param{}, ErrEffectType
+	}
+
+	param, ok := SchemaOf(p.Encoding()).Param(pre + name)
+	if !ok || param.Name == e.on || param.Name == e.typ {
+		return Param{}, ErrEffectParam
+	}
+	return param, nil
+}
+
+//Whether the block is switched on.
+func (e *Effect) On(p Patch) (bool, error) {
+	v, err := e.get(p, e.on)
+	return v != 0, err
+}
+
+//Switches the block on or off.
+func (e *Effect) SetOn(p Patch, on bool) error {
+	var v libktn.Uint14
+	if on {
+		v = 1
+	}
+	return e.set(p, e.on, v)
+}
+
+//Gets the raw type value of the block.
+func (e *Effect) Type(p Patch) (libktn.Uint7, error) {
+	v, err := e.get(p, e.typ)
+	return libktn.Uint7(v), err
+}
+
+//Writes the raw type value of the block.
+func (e *Effect) SetType(p Patch, t libktn.Uint7) error {
+	return e.set(p, e.typ, libktn.Uint14(t))
+}
+
+//Reads a parameter of a type.
+func (e *Effect) GetFor(p Patch, typ string, name string) (libktn.Uint14, error) {
+	param, err := e.Param(p, typ, name)
+	if err != nil {
+		return 0, err
+	}
+	return Get(p, param)
+}
+
+//Writes a parameter of a type.
+func (e *Effect) SetFor(p Patch, typ string, name string, v libktn.Uint14) error {
+	param, err := e.Param(p, typ, name)
+	if err != nil {
+		return err
+	}
+	_, err = Set(p, param, v)
+	return err
+}
+
+//Reads all parameters of a type.
+func (e *Effect) Settings(p Patch, typ string) (map[string]libktn.Uint14, error) {
+	names, err := e.Params(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(map[string]libktn.Uint14, len(names))
+	for _, n := range names {
+		v, err := e.GetFor(p, typ, n)
+		if err != nil {
+			return nil, err
+		}
+		r[n] = v
+	}
+	return r, nil
+}
+
+//Writes parameters of a type.
+func (e *Effect) Apply(p Patch, typ string, settings map[string]libktn.Uint14) error {
+	for n, v := range settings {
+		if err := e.SetFor(p, typ, n, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Copies the settings of a type from one block to another, possibly across patches.
+//Such as copying the phaser settings of the mod block into the FX block.
+func CopyEffect(src Patch, from *Effect, dst Patch, to *Effect, typ string) error {
+	s, err := from.Settings(src, typ)
+	if err != nil {
+		return err
+	}
+	return to.Apply(dst, typ, s)
+}
+
+func (e *Effect) get(p Patch, name string) (libktn.Uint14, error) {
+	param, ok := SchemaOf(p.Encoding()).Param(name)
+	if !ok {
+		return 0, ErrEffectParam
+	}
+	return Get(p, param)
+}
+
+func (e *Effect) set(p Patch, name string, v libktn.Uint14) error {
+	param, ok := SchemaOf(p.Encoding()).Param(name)
+	if !ok {
+		return ErrEffectParam
+	}
+	_, err := Set(p, param, v)
+	return err
+}
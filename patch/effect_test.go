@@ -0,0 +1,88 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+func TestEffectParams(t *testing.T) {
+	n, err := EffectMod.Params("phaser")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"depth", "direct_mix", "effect_level", "manual", "rate", "reso", "step_rate", "type"}, n)
+
+	n, err = EffectFx.Params("teraecho")
+	assert.Nil(t, err)
+	assert.True(t, len(n) > 0)
+
+	_, err = EffectMod.Params("teraecho")
+	assert.Equal(t, ErrEffectType, err)
+	_, err = EffectMod.Params("")
+	assert.Equal(t, ErrEffectType, err)
+	assert.Equal(t, len(FxTypes)-2, len(EffectMod.Types()))
+	assert.Equal(t, len(FxTypes), len(EffectFx.Types()))
+
+	//The booster shares its parameters between types.
+	assert.Nil(t, EffectBooster.Types())
+	n, err = EffectBooster.Params("")
+	assert.Nil(t, err)
+	assert.Equal(t, 13, len(n))
+	_, err = EffectBooster.Params("blues_od")
+	assert.Equal(t, ErrEffectType, err)
+
+	e, ok := EffectByName("delay")
+	assert.True(t, ok)
+	assert.Equal(t, EffectDelay, e)
+}
+
+func TestEffectGetSet(t *testing.T) {
+	p := NewSparse()
+	assert.Nil(t, EffectMod.SetOn(p, true))
+	assert.Nil(t, EffectMod.SetType(p, 19))
+	assert.Nil(t, EffectMod.SetFor(p, "phaser", "rate", 42))
+
+	on, err := EffectMod.On(p)
+	assert.Nil(t, err)
+	assert.True(t, on)
+
+	typ, err := EffectMod.Type(p)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint7(19), typ)
+
+	v, err := Get(p, mustParam(t, "fx1_phaser_rate"))
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(42), v)
+
+	v, err = EffectMod.GetFor(p, "phaser", "rate")
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(42), v)
+
+	assert.Equal(t, ErrEffectParam, EffectMod.SetFor(p, "phaser", "nope", 1))
+	assert.Equal(t, ErrEffectParam, EffectMod.SetFor(p, "phaser", "fx_type", 1))
+	assert.Equal(t, ErrEffectType, EffectMod.SetFor(p, "overtone", "lower_level", 1))
+	assert.Equal(t, libktn.ErrOutOfBounds, EffectMod.SetType(p, 0x80))
+}
+
+func TestCopyEffect(t *testing.T) {
+	src := NewSparse()
+	dst := NewSparse()
+	assert.Nil(t, EffectMod.SetFor(src, "phaser", "rate", 10))
+	assert.Nil(t, EffectMod.SetFor(src, "phaser", "depth", 20))
+
+	assert.Nil(t, CopyEffect(src, EffectMod, dst, EffectFx, "phaser"))
+	v, err := Get(dst, mustParam(t, "fx2_phaser_depth"))
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(20), v)
+
+	s, err := EffectFx.Settings(dst, "phaser")
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(10), s["rate"])
+}
+
+func mustParam(t *testing.T, name string) Param {
+	p, ok := SchemaMkI.Param(name)
+	assert.True(t, ok)
+	return p
+}
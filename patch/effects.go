@@ -0,0 +1,16 @@
+package patch
+
+//Types of the fx1 (MOD) and fx2 (FX) blocks, by the infix of their parameters in the TSL map.
+//The fx1_fx_type and fx2_fx_type values stay raw numbers, see the package doc.
+var FxTypes = []string{
+	"sub_od_ds", "t_wah", "auto_wah", "sub_wah", "adv_comp", "limiter", "graphic_eq",
+	"parametric_eq", "tone_modify", "guitar_sim", "slow_gear", "defretter", "wave_synth",
+	"sitar_sim", "octave", "pitch_shifter", "harmonist", "sound_hold", "ac_processor",
+	"phaser", "flanger", "tremolo", "rotary", "uni_v", "pan", "slicer", "vibrato", "ring_mod",
+	"humanizer", "2x2_chorus", "sub_delay", "acsim", "rotary2", "teraecho", "overtone",
+}
+
+//Whether only the fx2 (FX) block has this type. Their parameters are named prm_fx2_*.
+func fx2Only(typ string) bool {
+	return typ == "teraecho" || typ == "overtone"
+}
@@ -0,0 +1,76 @@
+package patch
+
+import (
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+/*
+The dense patch implementation keeps every offset up to the last known parameter.
+At about 2.3KB per patch it uses twice the memory of the sparse patch,
+but it's lossless, so it preserves the assigns and other parameters the amp doesn't expose.
+*/
+type DensePatch struct {
+	data []byte
+}
+
+//Creates a new DensePatch instance.
+func NewDense() Patch {
+	return &DensePatch{data: make([]byte, lenPatch, lenPatch)}
+}
+
+func (p *DensePatch) Encoding() uint16 {
+	return EncDense
+}
+
+func (p *DensePatch) Clone() Patch {
+	c := &DensePatch{data: make([]byte, len(p.data))}
+	copy(c.data, p.data)
+	return c
+}
+
+func (p *DensePatch) ApplyMessage(msg *sysex.SysexMessage) WriteStat {
+	if msg.Op == sysex.OpCommand && sysex.MutablePatchRegions[msg.Address.Region] {
+		s, _ := p.WriteBytes(msg.Address.Offset, msg.Data)
+		return s
+	}
+	return WriteStat{discarded: libktn.Uint14(len(msg.Data))}
+}
+
+func (p *DensePatch) WriteBytes(offset libktn.Uint14, data []byte) (WriteStat, error) {
+	stat := WriteStat{}
+	if offset > offMax {
+		stat.discarded = libktn.Uint14(len(data))
+		return stat, nil
+	}
+
+	stat.written = libktn.Uint14(copy(p.data[offset:], data))
+	stat.discarded = libktn.Uint14(len(data)) - stat.written
+	return stat, nil
+}
+
+func (p *DensePatch) GetFxChain() []libktn.Uint7 {
+	c := make([]libktn.Uint7, lenFxChain)
+	for i, b := range p.data[offFxChain : offFxChain+lenFxChain] {
+		c[i] = libktn.Uint7(b)
+	}
+	return c
+}
+
+func (p *DensePatch) SetFxChain(c FxChain) error {
+	return writeFxChain(p, c)
+}
+
+func (p *DensePatch) GetByte(offset libktn.Uint14) (libktn.Uint7, error) {
+	if offset > offMax {
+		return 0, libktn.ErrOutOfBounds
+	}
+	return libktn.MakeUint7(p.data[offset])
+}
+
+func (p *DensePatch) GetShort(offset libktn.Uint14) (libktn.Uint14, error) {
+	if offset >= offMax {
+		return 0, libktn.ErrOutOfBounds
+	}
+	return libktn.MakeUint14(p.data[offset : offset+2])
+}
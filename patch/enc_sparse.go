@@ -14,7 +14,12 @@ const (
 
 type boundary struct{ begin, end, shift libktn.Uint14 }
 
-var bounds = [...]boundary{
+//The dense encoding keeps everything.
+var boundsDense = []boundary{
+	boundary{begin: 0, end: lenPatch, shift: 0},
+}
+
+var bounds = []boundary{
 	boundary{begin: 0, end: 107, shift: 0},
 	boundary{begin: 192, end: 1059, shift: 85},
 	boundary{begin: 2064, end: 2107, shift: 1090},
@@ -40,6 +45,16 @@ func NewSparse() Patch {
 	return &SparsePatch{data: make([]byte, sparseCap, sparseCap)}
 }
 
+func (p *SparsePatch) Encoding() uint16 {
+	return EncSparse
+}
+
+func (p *SparsePatch) Clone() Patch {
+	c := &SparsePatch{data: make([]byte, len(p.data))}
+	copy(c.data, p.data)
+	return c
+}
+
 func (p *SparsePatch) ApplyMessage(msg *sysex.SysexMessage) WriteStat {
 	if msg.Op == sysex.OpCommand && sysex.MutablePatchRegions[msg.Address.Region] {
 		s, _ := p.WriteBytes(msg.Address.Offset, msg.Data)
@@ -74,7 +89,7 @@ func (p *SparsePatch) WriteBytes(offset libktn.Uint14, data []byte) (WriteStat,
 			di += dif
 
 			//Don't move past the end of our data.
-			if di >= libktn.Uint14(len(data))-1 {
+			if di >= libktn.Uint14(len(data)) {
 				return stat, nil
 			}
 		}
@@ -92,19 +107,23 @@ func (p *SparsePatch) WriteBytes(offset libktn.Uint14, data []byte) (WriteStat,
 
 func (p *SparsePatch) GetFxChain() []libktn.Uint7 {
 	c := make([]libktn.Uint7, lenFxChain)
-	o := byteOffset(offFxChain)
+	o := p.byteOffset(offFxChain)
 	for i, b := range p.data[o : o+lenFxChain] {
 		c[i] = libktn.Uint7(b)
 	}
 	return c
 }
 
+func (p *SparsePatch) SetFxChain(c FxChain) error {
+	return writeFxChain(p, c)
+}
+
 func (p *SparsePatch) GetByte(offset libktn.Uint14) (libktn.Uint7, error) {
 	if offset > offMax {
 		return 0, libktn.ErrOutOfBounds
 	}
 
-	o := byteOffset(offset)
+	o := p.byteOffset(offset)
 	if o == offDiscard {
 		return 0, ErrDiscardedOffset
 	}
@@ -121,8 +140,8 @@ func (p *SparsePatch) GetShort(offset libktn.Uint14) (libktn.Uint14, error) {
 		return 0, libktn.ErrOutOfBounds
 	}
 
-	omsb := byteOffset(offset)
-	olsb := byteOffset(offset + 1)
+	omsb := p.byteOffset(offset)
+	olsb := p.byteOffset(offset + 1)
 	if omsb == offDiscard || olsb == offDiscard {
 		return 0, ErrDiscardedOffset
 	}
@@ -134,10 +153,10 @@ func (p *SparsePatch) GetShort(offset libktn.Uint14) (libktn.Uint14, error) {
 	return v, nil
 }
 
-func byteOffset(offset libktn.Uint14) libktn.Uint14 {
+func (p *SparsePatch) byteOffset(offset libktn.Uint14) libktn.Uint14 {
 	for _, b := range bounds {
 		//Try to move to the correct bound asap.
-		if b.end < offset {
+		if b.end <= offset {
 			continue
 		}
 
@@ -154,6 +173,16 @@ func byteOffset(offset libktn.Uint14) libktn.Uint14 {
 	return offDiscard
 }
 
+//Gets the offset ranges kept by an encoding.
+func boundsOf(enc uint16) []boundary {
+	switch enc {
+	case EncDense:
+		return boundsDense
+	default:
+		return bounds
+	}
+}
+
 func min14(a, b libktn.Uint14) libktn.Uint14 {
 	if a > b {
 		return b
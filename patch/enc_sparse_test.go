@@ -0,0 +1,36 @@
+package patch
+
+import (
+	"testing"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/stvp/assert"
+)
+
+func TestSparseBoundaryEnd(t *testing.T) {
+	p := NewSparse()
+	p.WriteBytes(192, []byte{0x42})
+
+	//107 is the first offset past the first boundary, not the first byte of the next.
+	_, err := p.GetByte(107)
+	assert.Equal(t, ErrDiscardedOffset, err)
+
+	v, err := p.GetByte(192)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint7(0x42), v)
+}
+
+func TestSparseWriteLastByteAfterGap(t *testing.T) {
+	//From 107 up to and including 192, only the last byte lands in a boundary.
+	data := make([]byte, 86)
+	data[85] = 0x42
+
+	p := NewSparse()
+	s, err := p.WriteBytes(107, data)
+	assert.Nil(t, err)
+	assert.Equal(t, WriteStat{written: 1, discarded: 85}, s)
+
+	v, err := p.GetByte(192)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint7(0x42), v)
+}
@@ -0,0 +1,198 @@
+package patch
+
+import (
+	"errors"
+	"fmt"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+const (
+	offFxBoxAsgn   = 2305 //12 01
+	offFxBoxSel    = 2320 //12 10
+	offFxActiveAB  = 2325 //12 15
+	lenFxBoxMemory = 22
+)
+
+var (
+	ErrFxBoxColour = errors.New("Unknown FX box colour")
+	ErrFxBoxSide   = errors.New("FX box side should be A or B")
+	ErrFxBoxIndex  = errors.New("Unknown FX box")
+)
+
+//Colour variation of an effect knob.
+type Colour libktn.Uint7
+
+const (
+	ColourGreen Colour = iota
+	ColourRed
+	ColourYellow
+
+	NumColours
+)
+
+func (c Colour) String() string {
+	switch c {
+	case ColourGreen:
+		return "green"
+	case ColourRed:
+		return "red"
+	case ColourYellow:
+		return "yellow"
+	default:
+		return fmt.Sprintf("colour_%d", int(c))
+	}
+}
+
+//One of the effect knobs that has colour variations.
+//Box 1 holds fx1 (MOD) types and box 2 holds fx2 (FX) types, each with an A and B side.
+//Box 3 isn't modelled and its bytes are left as they are.
+type FxBox int
+
+const (
+	FxBox1A FxBox = iota
+	FxBox1B
+	FxBox2A
+	FxBox2B
+
+	NumFxBoxes
+)
+
+var fxBoxNames = [NumFxBoxes]string{"fx1a", "fx1b", "fx2a", "fx2b"}
+
+func (b FxBox) String() string {
+	if b >= 0 && b < NumFxBoxes {
+		return fxBoxNames[b]
+	}
+	return fmt.Sprintf("fxbox_%d", int(b))
+}
+
+//Which side of the fx1 and fx2 boxes is active.
+type Side libktn.Uint7
+
+const (
+	SideA Side = 0
+	SideB Side = 1
+)
+
+//The colour to effect mapping of a patch, as in fxbox_asgn_*, fxbox_sel_* and fx_active_ab_*.
+//Delay and reverb colours are not part of this memory.
+type FxBoxes struct {
+	//Raw fx1_fx_type or fx2_fx_type value behind each colour of each box.
+	Assign [NumFxBoxes][NumColours]libktn.Uint7
+	//Colour currently selected for each box.
+	Selected [NumFxBoxes]Colour
+	//Active side for fx1 and fx2.
+	Active [2]Side
+}
+
+//Reads the FX box settings of a patch.
+func GetFxBoxes(p Patch) (FxBoxes, error) {
+	b := make([]byte, lenFxBoxMemory)
+	for i := range b {
+		v, err := p.GetByte(offFxBoxAsgn + libktn.Uint14(i))
+		if err != nil {
+			return FxBoxes{}, err
+		}
+		b[i] = byte(v)
+	}
+
+	r := FxBoxes{}
+	for box := range r.Assign {
+		for c := range r.Assign[box] {
+			r.Assign[box][c] = libktn.Uint7(b[box*int(NumColours)+c])
+		}
+		r.Selected[box] = Colour(b[offFxBoxSel-offFxBoxAsgn+box])
+	}
+	for i := range r.Active {
+		r.Active[i] = Side(b[offFxActiveAB-offFxBoxAsgn+i])
+	}
+
+	return r, nil
+}
+
+//Validates and writes FX box settings to a patch.
+//Box 3 is skipped, so the writes are split around it.
+func SetFxBoxes(p Patch, f FxBoxes) error {
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	var asgn, sel, active []byte
+	for box := range f.Assign {
+		for _, v := range f.Assign[box] {
+			asgn = append(asgn, byte(v))
+		}
+		sel = append(sel, byte(f.Selected[box]))
+	}
+	for _, s := range f.Active {
+		active = append(active, byte(s))
+	}
+
+	for _, w := range []struct {
+		o libktn.Uint14
+		b []byte
+	}{{offFxBoxAsgn, asgn}, {offFxBoxSel, sel}, {offFxActiveAB, active}} {
+		if _, err := p.WriteBytes(w.o, w.b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Checks the selected colours and active sides.
+func (f FxBoxes) Validate() error {
+	for box := range f.Assign {
+		if f.Selected[box] >= NumColours {
+			return ErrFxBoxColour
+		}
+	}
+	for _, s := range f.Active {
+		if s != SideA && s != SideB {
+			return ErrFxBoxSide
+		}
+	}
+	return nil
+}
+
+//Assigns a raw effect type value to a colour of a box.
+func (f *FxBoxes) Set(box FxBox, c Colour, v libktn.Uint7) error {
+	if box < 0 || box >= NumFxBoxes {
+		return ErrFxBoxIndex
+	}
+	if c >= NumColours {
+		return ErrFxBoxColour
+	}
+	f.Assign[box][c] = v
+	return nil
+}
+
+//Gets the raw effect type value of the selected colour of a box.
+func (f *FxBoxes) Effect(box FxBox) (libktn.Uint7, error) {
+	if box < 0 || box >= NumFxBoxes {
+		return 0, ErrFxBoxIndex
+	}
+
+	c := f.Selected[box]
+	if c >= NumColours {
+		return 0, ErrFxBoxColour
+	}
+	return f.Assign[box][c], nil
+}
+
+//Gets the box on the active side for fx1 (n = 1) or fx2 (n = 2).
+func (f *FxBoxes) ActiveBox(n int) (FxBox, error) {
+	if n != 1 && n != 2 {
+		return 0, ErrFxBoxIndex
+	}
+
+	s := f.Active[n-1]
+	if s != SideA && s != SideB {
+		return 0, ErrFxBoxSide
+	}
+
+	if n == 1 {
+		return FxBox1A + FxBox(s), nil
+	}
+	return FxBox2A + FxBox(s), nil
+}
@@ -0,0 +1,70 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+func TestFxBoxesRoundTrip(t *testing.T) {
+	f := FxBoxes{}
+	assert.Nil(t, f.Set(FxBox1A, ColourRed, 19))
+	assert.Nil(t, f.Set(FxBox2B, ColourYellow, 33))
+	f.Selected[FxBox1A] = ColourRed
+	f.Selected[FxBox2B] = ColourYellow
+	f.Active[1] = SideB
+
+	//Box 3 is left alone.
+	p := NewSparse()
+	fx3, _ := SchemaMkI.Param("fxbox_asgn_fx3_g")
+	_, err := Set(p, fx3, 5)
+	assert.Nil(t, err)
+	assert.Nil(t, SetFxBoxes(p, f))
+
+	//Spot check against the TSL map.
+	for name, exp := range map[string]libktn.Uint14{
+		"fxbox_asgn_fx1a_r": 19,
+		"fxbox_asgn_fx2b_y": 33,
+		"fxbox_asgn_fx3_g":  5,
+		"fxbox_sel_fx2b":    libktn.Uint14(ColourYellow),
+		"fx_active_ab_fx2":  libktn.Uint14(SideB),
+	} {
+		param, _ := SchemaMkI.Param(name)
+		v, err := Get(p, param)
+		assert.Nil(t, err)
+		assert.Equal(t, exp, v, name)
+	}
+
+	r, err := GetFxBoxes(p)
+	assert.Nil(t, err)
+	assert.Equal(t, f, r)
+
+	v, err := r.Effect(FxBox1A)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint7(19), v)
+
+	b, err := r.ActiveBox(2)
+	assert.Nil(t, err)
+	assert.Equal(t, FxBox2B, b)
+	b, err = r.ActiveBox(1)
+	assert.Nil(t, err)
+	assert.Equal(t, FxBox1A, b)
+}
+
+func TestFxBoxesValidation(t *testing.T) {
+	f := FxBoxes{}
+	assert.Equal(t, ErrFxBoxColour, f.Set(FxBox2A, NumColours, 0))
+	assert.Equal(t, ErrFxBoxIndex, f.Set(NumFxBoxes, ColourRed, 0))
+
+	f.Selected[FxBox2B] = 3
+	assert.Equal(t, ErrFxBoxColour, f.Validate())
+	assert.Equal(t, ErrFxBoxColour, SetFxBoxes(NewSparse(), f))
+	_, err := f.Effect(FxBox2B)
+	assert.Equal(t, ErrFxBoxColour, err)
+
+	f = FxBoxes{}
+	f.Active[0] = 2
+	assert.Equal(t, ErrFxBoxSide, f.Validate())
+}
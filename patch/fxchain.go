@@ -0,0 +1,165 @@
+package patch
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+var (
+	ErrChainLength  = fmt.Errorf("FX chain should have %d positions", lenFxChain)
+	ErrChainMissing = errors.New("Block is not part of the FX chain")
+	ErrChainPos     = errors.New("Position is out of range for the FX chain")
+)
+
+//A block in the FX chain, by its raw fx_chain_position value.
+type Block libktn.Uint7
+
+func (b Block) String() string {
+	return strconv.Itoa(int(b))
+}
+
+//Reported when a block doesn't appear exactly once in the chain.
+type ChainBlockError struct {
+	Block Block
+	Count int
+}
+
+func (e ChainBlockError) Error() string {
+	if e.Block >= lenFxChain {
+		return fmt.Sprintf("Unknown FX chain block %d", int(e.Block))
+	}
+	return fmt.Sprintf("FX chain block %s appears %d times, expecting once", e.Block, e.Count)
+}
+
+//The order of the blocks in the signal chain, from input to output.
+type FxChain []Block
+
+//Creates an FX chain from the raw fx_chain_position1..20 values.
+func DecodeFxChain(raw []libktn.Uint7) (FxChain, error) {
+	c := make(FxChain, len(raw))
+	for i, v := range raw {
+		c[i] = Block(v)
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+//Reads and validates the FX chain of a patch.
+func FxChainOf(p Patch) (FxChain, error) {
+	return DecodeFxChain(p.GetFxChain())
+}
+
+//Converts to the raw fx_chain_position1..20 values.
+func (c FxChain) Encode() []byte {
+	b := make([]byte, len(c))
+	for i, v := range c {
+		b[i] = byte(v)
+	}
+	return b
+}
+
+//Checks that every block appears exactly once.
+func (c FxChain) Validate() error {
+	if len(c) != lenFxChain {
+		return ErrChainLength
+	}
+
+	counts := make([]int, lenFxChain)
+	for _, b := range c {
+		if b >= lenFxChain {
+			return ChainBlockError{Block: b}
+		}
+		counts[b]++
+	}
+
+	for b, n := range counts {
+		if n != 1 {
+			return ChainBlockError{Block: Block(b), Count: n}
+		}
+	}
+	return nil
+}
+
+//Gets the position of a block, or -1 when it's not in the chain.
+func (c FxChain) Index(b Block) int {
+	for i, v := range c {
+		if v == b {
+			return i
+		}
+	}
+	return -1
+}
+
+//Creates a copy of the chain.
+func (c FxChain) Copy() FxChain {
+	r := make(FxChain, len(c))
+	copy(r, c)
+	return r
+}
+
+//Moves a block to a position, shifting the blocks in between.
+func (c FxChain) Move(b Block, pos int) error {
+	i := c.Index(b)
+	if i < 0 {
+		return ErrChainMissing
+	}
+	if pos < 0 || pos >= len(c) {
+		return ErrChainPos
+	}
+
+	if i < pos {
+		copy(c[i:pos], c[i+1:pos+1])
+	} else {
+		copy(c[pos+1:i+1], c[pos:i])
+	}
+	c[pos] = b
+	return nil
+}
+
+//Moves a block to just before another block.
+func (c FxChain) MoveBefore(b, ref Block) error {
+	i, j := c.Index(b), c.Index(ref)
+	if i < 0 || j < 0 {
+		return ErrChainMissing
+	}
+
+	//Removing the block shifts the reference down when it came first.
+	if i < j {
+		j--
+	}
+	return c.Move(b, j)
+}
+
+//Moves a block to just after another block.
+func (c FxChain) MoveAfter(b, ref Block) error {
+	i, j := c.Index(b), c.Index(ref)
+	if i < 0 || j < 0 {
+		return ErrChainMissing
+	}
+
+	if i > j {
+		j++
+	}
+	return c.Move(b, j)
+}
+
+//Writes a valid FX chain to a patch.
+//The chain_ptn parameter is left as it is, as the pattern a chain matches isn't known.
+func writeFxChain(p Patch, c FxChain) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	_, err := p.WriteBytes(offFxChain, c.Encode())
+	return err
+}
+
+//Reads the raw chain_ptn parameter of a patch.
+func ChainPatternOf(p Patch) (libktn.Uint7, error) {
+	return p.GetByte(offChainPtn)
+}
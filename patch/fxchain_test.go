@@ -0,0 +1,109 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+func identityChain() FxChain {
+	c := make(FxChain, lenFxChain)
+	for i := range c {
+		c[i] = Block(i)
+	}
+	return c
+}
+
+func TestDecodeFxChain(t *testing.T) {
+	raw := make([]libktn.Uint7, lenFxChain)
+	for i := range raw {
+		raw[i] = libktn.Uint7(lenFxChain - 1 - i)
+	}
+
+	c, e := DecodeFxChain(raw)
+	assert.Nil(t, e)
+	assert.Equal(t, Block(19), c[0])
+	assert.Equal(t, Block(0), c[19])
+
+	//A zeroed patch has every position set to the first block.
+	c, e = FxChainOf(NewSparse())
+	assert.Nil(t, c)
+	assert.Equal(t, ChainBlockError{Block: Block(0), Count: 20}, e)
+
+	c, e = DecodeFxChain(raw[:3])
+	assert.Nil(t, c)
+	assert.Equal(t, ErrChainLength, e)
+
+	raw[0] = 42
+	_, e = DecodeFxChain(raw)
+	assert.Equal(t, ChainBlockError{Block: 42}, e)
+}
+
+func TestValidateFxChain(t *testing.T) {
+	c := identityChain()
+	assert.Nil(t, c.Validate())
+
+	c[3] = Block(7)
+	assert.Equal(t, ChainBlockError{Block: Block(3), Count: 0}, c.Validate())
+}
+
+func TestMoveFxChain(t *testing.T) {
+	c := identityChain()
+	assert.Nil(t, c.MoveAfter(Block(1), Block(2)))
+	assert.Equal(t, FxChain{Block(0), Block(2), Block(1), Block(3)}, c[:4])
+
+	assert.Nil(t, c.MoveBefore(Block(1), Block(0)))
+	assert.Equal(t, FxChain{Block(1), Block(0), Block(2), Block(3)}, c[:4])
+
+	assert.Nil(t, c.MoveBefore(Block(0), Block(4)))
+	assert.Equal(t, FxChain{Block(1), Block(2), Block(3), Block(0), Block(4)}, c[:5])
+
+	assert.Nil(t, c.MoveAfter(Block(9), Block(2)))
+	assert.Equal(t, FxChain{Block(1), Block(2), Block(9), Block(3)}, c[:4])
+
+	assert.Nil(t, c.Move(Block(1), 19))
+	assert.Equal(t, Block(1), c[19])
+	assert.Equal(t, Block(2), c[0])
+	assert.Nil(t, c.Validate())
+
+	assert.Equal(t, ErrChainMissing, c.MoveAfter(42, Block(2)))
+	assert.Equal(t, ErrChainPos, c.Move(Block(2), 20))
+}
+
+func TestFxChainOf(t *testing.T) {
+	p := NewSparse()
+	c := identityChain()
+	assert.Nil(t, c.MoveAfter(Block(1), Block(2)))
+	assert.Nil(t, p.SetFxChain(c))
+
+	r, e := FxChainOf(p)
+	assert.Nil(t, e)
+	assert.Equal(t, c, r)
+
+	_, e = p.WriteBytes(offChainPtn, []byte{1})
+	assert.Nil(t, e)
+	ptn, e := ChainPatternOf(p)
+	assert.Nil(t, e)
+	assert.Equal(t, libktn.Uint7(1), ptn)
+}
+
+func TestSetFxChain(t *testing.T) {
+	for _, p := range []Patch{NewSparse(), NewDense()} {
+		c := identityChain()
+		assert.Nil(t, c.Move(Block(0), 19))
+		assert.Nil(t, p.SetFxChain(c))
+
+		r, e := FxChainOf(p)
+		assert.Nil(t, e)
+		assert.Equal(t, c, r)
+
+		//Broken chains aren't written.
+		c[0] = c[1]
+		assert.Equal(t, ChainBlockError{Block: 1, Count: 0}, p.SetFxChain(c))
+		r, e = FxChainOf(p)
+		assert.Nil(t, e)
+		assert.Equal(t, Block(1), r[0])
+	}
+}
@@ -0,0 +1,115 @@
+package patch
+
+import (
+	"strings"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+const (
+	offOutputSelect = 16 //00 10
+	nameMin         = 0x20
+	nameMax         = 0x7E
+	namePad         = ' '
+	nameUnknown     = '?'
+)
+
+//What SetName had to change about a name to store it.
+type NameReport struct {
+	//The name as it's stored, without padding.
+	Name string
+	//Whether characters beyond the 16th were dropped.
+	Truncated bool
+	//Characters that had to be replaced with ASCII ones.
+	Replaced []rune
+}
+
+//Whether the stored name differs from the one requested.
+func (r NameReport) Changed() bool {
+	return r.Truncated || len(r.Replaced) > 0
+}
+
+//ASCII stand-ins for common characters outside the patch name charset.
+var nameASCII = map[rune]string{
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Ä': "A", 'Å': "A", 'Æ': "AE", 'Ç': "C",
+	'È': "E", 'É': "E", 'Ê': "E", 'Ë': "E", 'Ì': "I", 'Í': "I", 'Î': "I", 'Ï': "I",
+	'Ð': "D", 'Ñ': "N", 'Ò': "O", 'Ó': "O", 'Ô': "O", 'Õ': "O", 'Ö': "O", 'Ø': "O",
+	'Ù': "U", 'Ú': "U", 'Û': "U", 'Ü': "U", 'Ý': "Y", 'ß': "ss",
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae", 'ç': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ð': "d", 'ñ': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'ÿ': "y",
+	'‘': "'", '’': "'", '‚': ",", '“': "\"", '”': "\"", '„': "\"",
+	'–': "-", '—': "-", '…': "...", '•': "*", '×': "x", '♯': "#", '♭': "b",
+	'\t': " ", ' ': " ",
+}
+
+//Converts a name to the characters a patch can hold, truncated to 16 characters.
+func SanitizeName(name string) NameReport {
+	r := NameReport{}
+	b := make([]byte, 0, lenName)
+
+	for _, c := range name {
+		s, ok := string(c), c >= nameMin && c <= nameMax
+		if !ok {
+			s = nameASCII[c]
+			if s == "" {
+				s = string(nameUnknown)
+			}
+		}
+
+		if len(b)+len(s) > lenName {
+			r.Truncated = true
+			break
+		}
+		b = append(b, s...)
+		if !ok {
+			r.Replaced = append(r.Replaced, c)
+		}
+	}
+
+	r.Name = string(b)
+	return r
+}
+
+//Reads the patch name, without the padding.
+func Name(p Patch) (string, error) {
+	b := make([]byte, lenName)
+	for i := range b {
+		v, err := p.GetByte(offName + libktn.Uint14(i))
+		if err != nil {
+			return "", err
+		}
+		b[i] = byte(v)
+	}
+
+	return strings.TrimRight(string(b), " \x00"), nil
+}
+
+//Stores a name, padded with spaces. The report tells what had to change to fit it.
+func SetName(p Patch, name string) (NameReport, error) {
+	r := SanitizeName(name)
+	b := []byte(r.Name)
+	for len(b) < lenName {
+		b = append(b, namePad)
+	}
+
+	_, err := p.WriteBytes(offName, b)
+	return r, err
+}
+
+//Reads the output_select parameter.
+//TODO: Document the output each value selects.
+func OutputSelect(p Patch) (libktn.Uint7, error) {
+	return p.GetByte(offOutputSelect)
+}
+
+//Writes the output_select parameter.
+func SetOutputSelect(p Patch, v libktn.Uint7) error {
+	b, err := v.Sysex()
+	if err != nil {
+		return err
+	}
+	_, err = p.WriteBytes(offOutputSelect, b)
+	return err
+}
@@ -0,0 +1,57 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+func TestSanitizeName(t *testing.T) {
+	r := SanitizeName("Crunch")
+	assert.Equal(t, "Crunch", r.Name)
+	assert.False(t, r.Changed())
+
+	r = SanitizeName("Motörhead – Ace")
+	assert.Equal(t, "Motorhead - Ace", r.Name)
+	assert.Equal(t, []rune{'ö', '–'}, r.Replaced)
+	assert.False(t, r.Truncated)
+
+	r = SanitizeName("A very long patch name")
+	assert.Equal(t, "A very long patc", r.Name)
+	assert.True(t, r.Truncated)
+
+	//Stand-ins longer than one character are dropped whole when they don't fit.
+	r = SanitizeName("Fifteen chars..…")
+	assert.Equal(t, "Fifteen chars..", r.Name)
+	assert.True(t, r.Truncated)
+
+	r = SanitizeName("Ψ")
+	assert.Equal(t, "?", r.Name)
+	assert.Equal(t, []rune{'Ψ'}, r.Replaced)
+}
+
+func TestSetName(t *testing.T) {
+	p := NewSparse()
+	r, err := SetName(p, "Lead")
+	assert.Nil(t, err)
+	assert.Equal(t, "Lead", r.Name)
+
+	b, err := p.GetByte(15)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint7(' '), b)
+
+	n, err := Name(p)
+	assert.Nil(t, err)
+	assert.Equal(t, "Lead", n)
+}
+
+func TestOutputSelect(t *testing.T) {
+	p := NewSparse()
+	assert.Nil(t, SetOutputSelect(p, 2))
+	v, err := OutputSelect(p)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint7(2), v)
+	assert.Equal(t, libktn.ErrOutOfBounds, SetOutputSelect(p, 0x80))
+}
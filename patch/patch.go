@@ -1,19 +1,26 @@
 package patch
 
 import (
+	"crypto/sha256"
 	"errors"
 
 	libktn "github.com/katana-dev/lib-katana"
 	"github.com/katana-dev/lib-katana/sysex"
 )
 
+//Encoding IDs are stored in binary patches and libraries, so they never change.
 const (
 	EncSparse uint16 = 0
+	EncDense  uint16 = 2
 )
 
 const (
-	offFxChain = 928 //07 20
-	lenFxChain = 20
+	offName     = 0
+	lenName     = 16
+	offFxChain  = 928 //07 20
+	lenFxChain  = 20
+	offChainPtn = 2304 //12 00
+	lenPatch    = offMax + 1
 )
 
 var (
@@ -23,19 +30,86 @@ var (
 
 type WriteStat struct{ written, discarded libktn.Uint14 }
 
+//Number of bytes kept by the patch.
+func (s WriteStat) Written() libktn.Uint14 {
+	return s.written
+}
+
+//Number of bytes the patch encoding discarded.
+func (s WriteStat) Discarded() libktn.Uint14 {
+	return s.discarded
+}
+
 type Patch interface {
+	Encoding() uint16
+	Clone() Patch
 	GetFxChain() []libktn.Uint7
+	SetFxChain(FxChain) error
 	GetByte(libktn.Uint14) (libktn.Uint7, error)
 	GetShort(libktn.Uint14) (libktn.Uint14, error)
 	WriteBytes(libktn.Uint14, []byte) (WriteStat, error)
 	ApplyMessage(*sysex.SysexMessage) WriteStat
 }
 
+//Creates a new Patch using the given encoding.
 func New(enc uint16) (Patch, error) {
 	switch enc {
 	case EncSparse:
 		return NewSparse(), nil
+	case EncDense:
+		return NewDense(), nil
 	default:
 		return nil, ErrUnknownEncoding
 	}
 }
+
+//Compares two patches, possibly of different encodings.
+//Offsets that either encoding discards are ignored.
+func Equal(a, b Patch) bool {
+	for i := libktn.Uint14(0); i <= offMax; i++ {
+		va, erra := a.GetByte(i)
+		vb, errb := b.GetByte(i)
+		if erra == ErrDiscardedOffset || errb == ErrDiscardedOffset {
+			continue
+		}
+		if va != vb || erra != errb {
+			return false
+		}
+	}
+	return true
+}
+
+//Creates a SHA-256 hash of the patch, over the supported parameters of SchemaMkI in offset order.
+//Every encoding keeps those, so Equal patches hash the same whatever their encoding.
+//Bytes only the dense encoding keeps, such as the assigns, don't change the hash.
+func Hash(p Patch) [sha256.Size]byte {
+	h := sha256.New()
+	for _, param := range SchemaMkI.Supported() {
+		for i := libktn.Uint14(0); i < param.Size; i++ {
+			v, err := p.GetByte(param.Offset + i)
+			if err != nil {
+				v = padVal
+			}
+			h.Write([]byte{byte(v)})
+		}
+	}
+
+	var r [sha256.Size]byte
+	copy(r[:], h.Sum(nil))
+	return r
+}
+
+//Reads the full patch memory into a dense byte slice.
+//Offsets that are discarded by the encoding are filled with padding.
+func Bytes(p Patch) []byte {
+	b := make([]byte, lenPatch)
+	for i := range b {
+		v, err := p.GetByte(libktn.Uint14(i))
+		if err != nil {
+			b[i] = padVal
+			continue
+		}
+		b[i] = byte(v)
+	}
+	return b
+}
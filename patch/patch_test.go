@@ -0,0 +1,81 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+func TestClone(t *testing.T) {
+	for _, p := range []Patch{NewSparse(), NewDense()} {
+		_, err := SetName(p, "Original")
+		assert.Nil(t, err)
+
+		c := p.Clone()
+		assert.Equal(t, p.Encoding(), c.Encoding())
+		assert.True(t, Equal(p, c))
+		assert.Equal(t, Hash(p), Hash(c))
+
+		//Changes must not leak into the original.
+		_, err = SetName(c, "Changed")
+		assert.Nil(t, err)
+		n, err := Name(p)
+		assert.Nil(t, err)
+		assert.Equal(t, "Original", n)
+		assert.False(t, Equal(p, c))
+		assert.NotEqual(t, Hash(p), Hash(c))
+	}
+}
+
+func TestEqualAcrossEncodings(t *testing.T) {
+	s := NewSparse()
+	d := NewDense()
+	for _, p := range []Patch{s, d} {
+		_, err := SetName(p, "Same")
+		assert.Nil(t, err)
+	}
+
+	//The sparse encoding doesn't keep the assigns.
+	_, err := SetAssign(d, 1, Assign{On: true})
+	assert.Nil(t, err)
+	assert.True(t, Equal(s, d))
+	assert.True(t, Equal(d, s))
+	assert.Equal(t, Hash(s), Hash(d))
+	c, _, err := Convert(d, EncSparse)
+	assert.Nil(t, err)
+	assert.Equal(t, Hash(d), Hash(c))
+
+	_, err = d.WriteBytes(offOutputSelect, []byte{1})
+	assert.Nil(t, err)
+	assert.False(t, Equal(s, d))
+	assert.NotEqual(t, Hash(s), Hash(d))
+}
+
+func FuzzSparseWriteBytes(f *testing.F) {
+	f.Add(uint16(0), []byte("Chunky"))
+	f.Add(uint16(offFxChain), []byte{0x01, 0x02, 0x03})
+	f.Add(uint16(1055), make([]byte, 20))
+	f.Add(uint16(offMax), []byte{0x7F, 0x7F})
+
+	f.Fuzz(func(t *testing.T, offset uint16, data []byte) {
+		if offset > 0x3FFF || len(data) > 0x3FFF {
+			return
+		}
+		o := libktn.Uint14(offset)
+
+		p := NewSparse()
+		s, err := p.WriteBytes(o, data)
+		assert.Nil(t, err)
+		assert.Equal(t, libktn.Uint14(len(data)), s.Written()+s.Discarded())
+
+		//Everything that was kept reads back.
+		for i, b := range data {
+			v, err := p.GetByte(o + libktn.Uint14(i))
+			if err == nil && b <= 0x7F {
+				assert.Equal(t, libktn.Uint7(b), v)
+			}
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package patch
+
+import (
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/model"
+)
+
+//A single named parameter in patch memory.
+type Param struct {
+	Name      string
+	Offset    libktn.Uint14
+	Size      libktn.Uint14
+	Supported bool
+}
+
+//The parameters of a Katana generation, ordered by offset.
+type Schema struct {
+	Name       string
+	Generation model.Generation
+	Params     []Param
+
+	byName map[string]int
+}
+
+var (
+	//There's no TSL map of the MkII yet, so only the original Katana has a schema.
+	SchemaMkI = newSchema("Katana", model.MkI, paramsMkI)
+
+	//Every parameter in the TSL map, as kept by the dense encoding.
+	SchemaAll = newSchema("Lossless", 0, allSupported(paramsMkI))
+)
+
+func allSupported(params []Param) []Param {
+	r := make([]Param, len(params))
+	for i, p := range params {
+		p.Supported = true
+		r[i] = p
+	}
+	return r
+}
+
+func newSchema(name string, gen model.Generation, params []Param) *Schema {
+	s := &Schema{Name: name, Generation: gen, Params: params, byName: make(map[string]int, len(params))}
+	for i, p := range params {
+		s.byName[p.Name] = i
+	}
+	return s
+}
+
+//Finds a parameter by its TSL name.
+func (s *Schema) Param(name string) (Param, bool) {
+	i, ok := s.byName[name]
+	if !ok {
+		return Param{}, false
+	}
+	return s.Params[i], true
+}
+
+//Finds the parameter that covers a patch offset.
+func (s *Schema) At(offset libktn.Uint14) (Param, bool) {
+	for _, p := range s.Params {
+		if offset >= p.Offset && offset < p.Offset+p.Size {
+			return p, true
+		}
+		if p.Offset > offset {
+			break
+		}
+	}
+	return Param{}, false
+}
+
+//Lists the parameters this generation supports.
+func (s *Schema) Supported() []Param {
+	var r []Param
+	for _, p := range s.Params {
+		if p.Supported {
+			r = append(r, p)
+		}
+	}
+	return r
+}
+
+//Gets the schema for a Katana generation.
+func SchemaFor(gen model.Generation) *Schema {
+	return SchemaMkI
+}
+
+//Gets the schema a patch encoding was designed for.
+func SchemaOf(enc uint16) *Schema {
+	switch enc {
+	case EncDense:
+		return SchemaAll
+	default:
+		return SchemaMkI
+	}
+}
+
+//Gets the encoding that keeps the parameters of a Katana generation.
+func EncodingFor(gen model.Generation) uint16 {
+	return EncSparse
+}
+
+//Reads a parameter value from a patch.
+func Get(p Patch, param Param) (libktn.Uint14, error) {
+	if param.Size == 2 {
+		return p.GetShort(param.Offset)
+	}
+
+	v, err := p.GetByte(param.Offset)
+	return libktn.Uint14(v), err
+}
+
+//Writes a parameter value to a patch.
+func Set(p Patch, param Param, v libktn.Uint14) (WriteStat, error) {
+	if param.Size == 2 {
+		b, err := v.Sysex()
+		if err != nil {
+			return WriteStat{}, err
+		}
+		return p.WriteBytes(param.Offset, b)
+	}
+
+	if v > 0x7F {
+		return WriteStat{}, libktn.ErrOutOfBounds
+	}
+	return p.WriteBytes(param.Offset, []byte{byte(v)})
+}
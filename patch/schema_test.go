@@ -0,0 +1,90 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/model"
+)
+
+func TestSchema(t *testing.T) {
+	p, ok := SchemaMkI.Param("delay_delay_time")
+	assert.True(t, ok)
+	assert.Equal(t, Param{Name: "delay_delay_time", Offset: 738, Size: 2, Supported: true}, p)
+
+	p, ok = SchemaMkI.At(739)
+	assert.True(t, ok)
+	assert.Equal(t, "delay_delay_time", p.Name)
+
+	_, ok = SchemaMkI.At(1500)
+	assert.False(t, ok)
+	_, ok = SchemaMkI.Param("nope")
+	assert.False(t, ok)
+
+	p, _ = SchemaMkI.Param("eq_on_off")
+	assert.False(t, p.Supported)
+	p, _ = SchemaAll.Param("eq_on_off")
+	assert.True(t, p.Supported)
+
+	assert.Equal(t, SchemaMkI, SchemaFor(model.MkI))
+	assert.Equal(t, EncSparse, EncodingFor(model.MkI))
+	assert.Equal(t, SchemaMkI, SchemaOf(EncSparse))
+}
+
+func TestSchemaMatchesBounds(t *testing.T) {
+	//Every supported parameter should be kept by its encoding.
+	for _, enc := range []uint16{EncSparse} {
+		s := SchemaOf(enc)
+		p, _ := New(enc)
+		for _, param := range s.Supported() {
+			_, err := Get(p, param)
+			assert.Nil(t, err, param.Name)
+		}
+	}
+}
+
+func TestSetShort(t *testing.T) {
+	p := NewSparse()
+	dt, _ := SchemaMkI.Param("delay_delay_time")
+	_, err := Set(p, dt, 1000)
+	assert.Nil(t, err)
+	v, err := Get(p, dt)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(1000), v)
+
+	lvl, _ := SchemaMkI.Param("patch_level")
+	_, err = Set(p, lvl, 0x80)
+	assert.Equal(t, libktn.ErrOutOfBounds, err)
+}
+
+func TestConvert(t *testing.T) {
+	src := NewDense()
+	set := func(name string, v libktn.Uint14) {
+		param, _ := SchemaAll.Param(name)
+		_, err := Set(src, param, v)
+		assert.Nil(t, err)
+	}
+	set("patch_name1", 'X')
+	set("eq_on_off", 1)
+	set("eq_low_gain", 30)
+	set("eq_high_gain", 0)
+
+	dst, r, err := Convert(src, EncSparse)
+	assert.Nil(t, err)
+	assert.Equal(t, EncSparse, dst.Encoding())
+	assert.Equal(t, []string{"eq_on_off", "eq_low_gain"}, r.Lost)
+
+	v, _ := dst.GetByte(0)
+	assert.Equal(t, libktn.Uint7('X'), v)
+
+	//Everything fits the other way around.
+	back, r, err := Convert(dst, EncDense)
+	assert.Nil(t, err)
+	assert.Nil(t, r.Lost)
+	assert.Equal(t, Bytes(dst), Bytes(back))
+
+	_, _, err = Convert(src, 42)
+	assert.Equal(t, ErrUnknownEncoding, err)
+}
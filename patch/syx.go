@@ -0,0 +1,163 @@
+package patch
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+const (
+	//Amount of data bytes per command when writing patches.
+	DefaultChunkSize = 0x80
+)
+
+var (
+	ErrNoPatchData = errors.New("No commands for the patch region were found")
+	ErrChunkSize   = errors.New("Chunk size should be at least 1")
+)
+
+//Builds a patch from the command messages writing to the given region.
+//Messages for other regions or of other types are ignored.
+func FromMessages(enc uint16, region libktn.Uint14, msgs []*sysex.SysexMessage) (Patch, error) {
+	p, err := New(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, m := range msgs {
+		if m.Op != sysex.OpCommand || m.Address.Region != region {
+			continue
+		}
+		p.ApplyMessage(m)
+		found = true
+	}
+
+	if !found {
+		return nil, ErrNoPatchData
+	}
+	return p, nil
+}
+
+//Creates the command messages needed to write a patch to a region.
+//Only the ranges kept by the patch encodings are included, chunked to at most chunk bytes each.
+func Commands(p Patch, region libktn.Uint14, chunk int) ([]sysex.SysexMessage, error) {
+	if chunk < 1 {
+		return nil, ErrChunkSize
+	}
+
+	data := Bytes(p)
+	var msgs []sysex.SysexMessage
+	for _, b := range boundsOf(p.Encoding()) {
+		for o := int(b.begin); o < int(b.end); o += chunk {
+			e := o + chunk
+			if e > int(b.end) {
+				e = int(b.end)
+			}
+
+			a := sysex.Address{Region: region, Offset: libktn.Uint14(o)}
+			msgs = append(msgs, sysex.MakeCommand(a, data[o:e]))
+		}
+	}
+	return msgs, nil
+}
+
+//Creates the query messages needed to read the ranges an encoding keeps from a region.
+func Queries(enc uint16, region libktn.Uint14, chunk int) ([]sysex.SysexMessage, error) {
+	if chunk < 1 {
+		return nil, ErrChunkSize
+	}
+
+	var msgs []sysex.SysexMessage
+	for _, b := range boundsOf(enc) {
+		for o := int(b.begin); o < int(b.end); o += chunk {
+			e := o + chunk
+			if e > int(b.end) {
+				e = int(b.end)
+			}
+
+			a := sysex.Address{Region: region, Offset: libktn.Uint14(o)}
+			msgs = append(msgs, sysex.MakeQuery(a, libktn.Uint28(e-o)))
+		}
+	}
+	return msgs, nil
+}
+
+//Creates the command messages that turn patch a into patch b, which should use the same encoding.
+//Each run of changed bytes is chunked to at most chunk bytes per message, like Commands.
+func DiffCommands(a, b Patch, region libktn.Uint14, chunk int) ([]sysex.SysexMessage, error) {
+	if chunk < 1 {
+		return nil, ErrChunkSize
+	}
+
+	da, db := Bytes(a), Bytes(b)
+	var msgs []sysex.SysexMessage
+	for o := 0; o < len(db); o++ {
+		if da[o] == db[o] {
+			continue
+		}
+
+		e := o
+		for e < len(db) && e-o < chunk && da[e] != db[e] {
+			e++
+		}
+		msgs = append(msgs, sysex.MakeCommand(sysex.Address{Region: region, Offset: libktn.Uint14(o)}, db[o:e]))
+		o = e - 1
+	}
+	return msgs, nil
+}
+
+//Files are read strictly, so a frame with a bad checksum is skipped instead of applied.
+var syxParser = sysex.NewParser(sysex.StrictOptions)
+
+//Reads a patch for the given region from a .syx stream.
+//Frames that can't be read are skipped, they're returned as sysex.ReadErrors along with the patch.
+func ReadSyx(r io.Reader, enc uint16, region libktn.Uint14) (Patch, error) {
+	msgs, err := syxParser.ReadAll(r)
+	skipped, ok := err.(sysex.ReadErrors)
+	if err != nil && !ok {
+		return nil, err
+	}
+
+	p, err := FromMessages(enc, region, msgs)
+	if err != nil {
+		return nil, err
+	}
+	if skipped != nil {
+		return p, skipped
+	}
+	return p, nil
+}
+
+//Writes a patch for the given region as a .syx stream.
+func WriteSyx(w io.Writer, p Patch, region libktn.Uint14) error {
+	msgs, err := Commands(p, region, DefaultChunkSize)
+	if err != nil {
+		return err
+	}
+	return sysex.WriteAll(w, msgs)
+}
+
+//Reads a patch for the given region from a .syx file.
+func ReadSyxFile(path string, enc uint16, region libktn.Uint14) (Patch, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadSyx(f, enc, region)
+}
+
+//Writes a patch for the given region to a .syx file.
+func WriteSyxFile(path string, p Patch, region libktn.Uint14) error {
+	b := bytes.Buffer{}
+	if err := WriteSyx(&b, p, region); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b.Bytes(), 0644)
+}
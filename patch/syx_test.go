@@ -0,0 +1,134 @@
+package patch
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+func TestCommands(t *testing.T) {
+	p := NewSparse()
+	_, err := p.WriteBytes(0, []byte("Chunky"))
+	assert.Nil(t, err)
+
+	msgs, err := Commands(p, sysex.CH3Region, 64)
+	assert.Nil(t, err)
+
+	//Every message fits the chunk size and stays inside the kept ranges.
+	total := 0
+	for _, m := range msgs {
+		assert.Equal(t, sysex.OpCommand, int(m.Op))
+		assert.Equal(t, sysex.CH3Region, int(m.Address.Region))
+		assert.True(t, len(m.Data) <= 64)
+		assert.True(t, p.(*SparsePatch).byteOffset(m.Address.Offset) != offDiscard)
+		total += len(m.Data)
+	}
+	assert.Equal(t, sparseCap, total)
+	assert.Equal(t, []byte("Chunky"), msgs[0].Data[:6])
+
+	_, err = Commands(p, sysex.CH3Region, 0)
+	assert.Equal(t, ErrChunkSize, err)
+}
+
+func TestSyxRoundTrip(t *testing.T) {
+	p := NewSparse()
+	_, err := p.WriteBytes(0, []byte("Round trip"))
+	assert.Nil(t, err)
+	_, err = p.WriteBytes(offFxChain, []byte{1, 2, 3})
+	assert.Nil(t, err)
+
+	b := bytes.Buffer{}
+	assert.Nil(t, WriteSyx(&b, p, sysex.CH2Region))
+
+	r, err := ReadSyx(bytes.NewReader(b.Bytes()), EncSparse, sysex.CH2Region)
+	assert.Nil(t, err)
+	assert.Equal(t, Bytes(p), Bytes(r))
+
+	_, err = ReadSyx(bytes.NewReader(b.Bytes()), EncSparse, sysex.CH1Region)
+	assert.Equal(t, ErrNoPatchData, err)
+
+	//A frame of another device doesn't stop the rest from being read.
+	other := []byte{0xF0, 0x43, 0x10, 0x4C, 0x00, 0x00, 0x7E, 0x00, 0xF7}
+	r, err = ReadSyx(bytes.NewReader(append(other, b.Bytes()...)), EncSparse, sysex.CH2Region)
+	_, ok := err.(sysex.ReadErrors)
+	assert.True(t, ok)
+	assert.Equal(t, Bytes(p), Bytes(r))
+
+	//Frames with a bad checksum aren't applied.
+	bad := sysex.MakeCommand(sysex.Address{Region: sysex.CH2Region}, []byte("Corrupt"))
+	f, err := bad.Sysex()
+	assert.Nil(t, err)
+	f[len(f)-2] ^= 1
+	good, err := Commands(p, sysex.CH2Region, DefaultChunkSize)
+	assert.Nil(t, err)
+	r, err = ReadSyx(bytes.NewReader(append(b.Bytes(), f...)), EncSparse, sysex.CH2Region)
+	assert.Equal(t, sysex.ReadErrors{{Frame: len(good), Err: sysex.ErrBadChecksum}}, err)
+	assert.Equal(t, Bytes(p), Bytes(r))
+}
+
+func TestQueries(t *testing.T) {
+	msgs, err := Queries(EncSparse, sysex.PanelRegion, DefaultChunkSize)
+	assert.Nil(t, err)
+
+	total := 0
+	for _, m := range msgs {
+		assert.Equal(t, byte(sysex.OpQuery), m.Op)
+		total += int(m.Size)
+	}
+	assert.Equal(t, sparseCap, total)
+
+	_, err = Queries(EncSparse, sysex.PanelRegion, 0)
+	assert.Equal(t, ErrChunkSize, err)
+}
+
+func TestDiffCommands(t *testing.T) {
+	a := NewSparse()
+	b := a.Clone()
+	msgs, err := DiffCommands(a, b, sysex.PanelRegion, DefaultChunkSize)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(msgs))
+
+	_, err = b.WriteBytes(2, []byte{1, 2})
+	assert.Nil(t, err)
+	_, err = b.WriteBytes(16, []byte{3})
+	assert.Nil(t, err)
+
+	msgs, err = DiffCommands(a, b, sysex.PanelRegion, DefaultChunkSize)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(msgs))
+	assert.Equal(t, sysex.Address{Region: sysex.PanelRegion, Offset: 2}, msgs[0].Address)
+	assert.Equal(t, []byte{1, 2}, msgs[0].Data)
+	assert.Equal(t, []byte{3}, msgs[1].Data)
+
+	_, err = DiffCommands(a, b, sysex.PanelRegion, 0)
+	assert.Equal(t, ErrChunkSize, err)
+}
+
+func TestDiffCommandsChunked(t *testing.T) {
+	a := NewDense()
+	b := a.Clone()
+	full := make([]byte, offMax+1)
+	for i := range full {
+		full[i] = 1
+	}
+	_, err := b.WriteBytes(0, full)
+	assert.Nil(t, err)
+
+	msgs, err := DiffCommands(a, b, sysex.PanelRegion, DefaultChunkSize)
+	assert.Nil(t, err)
+
+	total := 0
+	for i, m := range msgs {
+		assert.True(t, len(m.Data) <= DefaultChunkSize)
+		if i > 0 {
+			prev := msgs[i-1]
+			assert.Equal(t, prev.Address.Offset+libktn.Uint14(len(prev.Data)), m.Address.Offset)
+		}
+		total += len(m.Data)
+	}
+	assert.Equal(t, len(full), total)
+}
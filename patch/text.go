@@ -0,0 +1,326 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+//A parameter value as shown in text formats.
+//Numbers and booleans are written without quotes.
+//Units aren't shown, as the TSL map doesn't say which parameters have them.
+type Value string
+
+func (v Value) plain() bool {
+	if v == "true" || v == "false" {
+		return true
+	}
+	//Only the way Itoa writes numbers is valid JSON, not "+5" or "0123".
+	n, err := strconv.Atoi(string(v))
+	return err == nil && strconv.Itoa(n) == string(v)
+}
+
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v.plain() {
+		return []byte(v), nil
+	}
+	return json.Marshal(string(v))
+}
+
+func (v *Value) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*v = Value(s)
+		return nil
+	}
+
+	//Any other JSON value is kept as written, which covers numbers and booleans.
+	*v = Value(bytes.TrimSpace(b))
+	return nil
+}
+
+//A patch as TSL parameter names and display values, grouped by effect block.
+//The name and FX chain have their own fields instead of the per character
+//and per position parameters. The chain holds the raw block IDs.
+type Text struct {
+	Encoding string                      `json:"encoding"`
+	Name     string                      `json:"name"`
+	Chain    []int                       `json:"chain"`
+	Params   map[string]map[string]Value `json:"params"`
+}
+
+//Reported when a text patch has a parameter or value that can't be read.
+type TextError struct {
+	Param string
+	Value string
+}
+
+func (e TextError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("Unknown parameter %s", e.Param)
+	}
+	return fmt.Sprintf("Invalid value %q for %s", e.Value, e.Param)
+}
+
+//Reported when a parameter appears in more than one group of a text patch.
+type TextDuplicateError string
+
+func (e TextDuplicateError) Error() string {
+	return fmt.Sprintf("Parameter %s appears more than once", string(e))
+}
+
+var encodingNames = map[uint16]string{
+	EncSparse: "sparse",
+	EncDense:  "dense",
+}
+
+//Groups of parameters whose block isn't the first word of their name.
+var textGroups = []struct{ prefix, group string }{
+	{"od_ds_", "booster"},
+	{"preamp_a_", "amp"},
+	{"preamp_b_", "amp_b"},
+	{"fx1_", "mod"},
+	{"fx2_", "fx"},
+	{"prm_fx1_", "mod"},
+	{"prm_fx2_", "fx"},
+	{"pedal_fx_", "pedal_fx"},
+	{"foot_volume_", "foot_volume"},
+	{"send_return_", "send_return"},
+	{"accel_fx_", "accel_fx"},
+	{"output_select", "patch"},
+	{"chain_ptn", "patch"},
+	{"fx_active_ab_", "fxbox"},
+}
+
+func textGroup(name string) string {
+	for _, g := range textGroups {
+		if strings.HasPrefix(name, g.prefix) {
+			return g.group
+		}
+	}
+	if i := strings.Index(name, "_"); i > 0 {
+		return name[:i]
+	}
+	return name
+}
+
+//Parameters with their own field in Text.
+func textField(name string) bool {
+	return strings.HasPrefix(name, "patch_name") || strings.HasPrefix(name, "fx_chain_position")
+}
+
+//How a parameter is shown.
+type textKind int
+
+const (
+	kindNumber textKind = iota
+	kindSwitch
+	kindColour
+	kindSide
+)
+
+func kindOf(name string) textKind {
+	switch {
+	case isSwitch(name):
+		return kindSwitch
+	case strings.HasPrefix(name, "fxbox_sel_"):
+		return kindColour
+	case strings.HasPrefix(name, "fx_active_ab_"):
+		return kindSide
+	default:
+		return kindNumber
+	}
+}
+
+func formatValue(k textKind, v libktn.Uint14) Value {
+	switch {
+	case k == kindSwitch && v <= 1:
+		return Value(strconv.FormatBool(v == 1))
+	case k == kindColour && Colour(v) < NumColours:
+		return Value(Colour(v).String())
+	case k == kindSide && Side(v) <= SideB:
+		return Value(string('a' + byte(v)))
+	default:
+		//Out of range values stay numbers, so nothing is lost.
+		return Value(strconv.Itoa(int(v)))
+	}
+}
+
+func parseValue(k textKind, v Value) (libktn.Uint14, bool) {
+	if n, err := strconv.Atoi(string(v)); err == nil {
+		return libktn.Uint14(n), n >= 0 && n <= 0x3FFF
+	}
+
+	s := string(v)
+	switch k {
+	case kindSwitch:
+		switch s {
+		case "true":
+			return 1, true
+		case "false":
+			return 0, true
+		}
+	case kindColour:
+		for c := Colour(0); c < NumColours; c++ {
+			if c.String() == s {
+				return libktn.Uint14(c), true
+			}
+		}
+	case kindSide:
+		if s == "a" || s == "b" {
+			return libktn.Uint14(s[0] - 'a'), true
+		}
+	}
+	return 0, false
+}
+
+//Converts a patch to its text form. Only parameters the encoding keeps are included.
+func ToText(p Patch) (Text, error) {
+	enc, ok := encodingNames[p.Encoding()]
+	if !ok {
+		return Text{}, ErrUnknownEncoding
+	}
+
+	n, err := Name(p)
+	if err != nil {
+		return Text{}, err
+	}
+
+	t := Text{Encoding: enc, Name: n, Params: map[string]map[string]Value{}}
+	for _, b := range p.GetFxChain() {
+		t.Chain = append(t.Chain, int(b))
+	}
+
+	for _, param := range SchemaOf(p.Encoding()).Supported() {
+		if textField(param.Name) {
+			continue
+		}
+
+		v, err := Get(p, param)
+		if err == ErrDiscardedOffset {
+			continue
+		}
+		if err != nil {
+			return Text{}, TextError{Param: param.Name, Value: "?"}
+		}
+
+		g := textGroup(param.Name)
+		if t.Params[g] == nil {
+			t.Params[g] = map[string]Value{}
+		}
+		t.Params[g][param.Name] = formatValue(kindOf(param.Name), v)
+	}
+
+	return t, nil
+}
+
+//Creates a patch from its text form.
+//Parameters that are left out keep their default of 0.
+func FromText(t Text) (Patch, error) {
+	enc := EncSparse
+	found := false
+	for e, n := range encodingNames {
+		if n == t.Encoding {
+			enc, found = e, true
+		}
+	}
+	if !found && t.Encoding != "" {
+		return nil, ErrUnknownEncoding
+	}
+
+	p, err := New(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	s := SchemaOf(enc)
+	seen := map[string]bool{}
+	for _, group := range t.Params {
+		for name, v := range group {
+			param, ok := s.Param(name)
+			if !ok || textField(name) {
+				return nil, TextError{Param: name}
+			}
+			//Which of the values to keep would depend on map order.
+			if seen[name] {
+				return nil, TextDuplicateError(name)
+			}
+			seen[name] = true
+
+			n, ok := parseValue(kindOf(name), v)
+			if !ok {
+				return nil, TextError{Param: name, Value: string(v)}
+			}
+			if _, err := Set(p, param, n); err != nil {
+				return nil, TextError{Param: name, Value: string(v)}
+			}
+		}
+	}
+
+	//Written as is rather than through SetName, any 7 bit characters survive a round trip.
+	name := []byte(t.Name)
+	if len(name) > lenName {
+		return nil, TextError{Param: "name", Value: t.Name}
+	}
+	for len(name) < lenName {
+		name = append(name, namePad)
+	}
+	for _, c := range name {
+		if c > 0x7F {
+			return nil, TextError{Param: "name", Value: t.Name}
+		}
+	}
+	if _, err := p.WriteBytes(offName, name); err != nil {
+		return nil, err
+	}
+
+	if t.Chain != nil {
+		if len(t.Chain) != lenFxChain {
+			return nil, ErrChainLength
+		}
+
+		raw := make([]byte, lenFxChain)
+		for i, n := range t.Chain {
+			if n < 0 || n > 0x7F {
+				return nil, TextError{Param: "chain", Value: strconv.Itoa(n)}
+			}
+			raw[i] = byte(n)
+		}
+
+		//Written as is, so a broken chain survives a round trip. See Validate.
+		if _, err := p.WriteBytes(offFxChain, raw); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+//Writes a patch as indented JSON.
+func WriteJSON(w io.Writer, p Patch) error {
+	t, err := ToText(p)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+//Reads a patch written by WriteJSON.
+func ReadJSON(r io.Reader) (Patch, error) {
+	t := Text{}
+	if err := json.NewDecoder(r).Decode(&t); err != nil {
+		return nil, err
+	}
+	return FromText(t)
+}
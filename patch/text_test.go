@@ -0,0 +1,144 @@
+package patch
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+func textPatch(t *testing.T) Patch {
+	p := NewSparse()
+	_, err := SetName(p, `Say "hi" #1`)
+	assert.Nil(t, err)
+	c := identityChain()
+	assert.Nil(t, c.MoveAfter(Block(5), Block(2)))
+	assert.Nil(t, p.SetFxChain(c))
+	_, err = p.WriteBytes(offChainPtn, []byte{1})
+	assert.Nil(t, err)
+	assert.Nil(t, EffectBooster.SetOn(p, true))
+	assert.Nil(t, EffectBooster.SetType(p, 10))
+	assert.Nil(t, EffectMod.SetType(p, 19))
+	assert.Nil(t, EffectMod.SetFor(p, "phaser", "rate", 33))
+
+	f := FxBoxes{}
+	assert.Nil(t, f.Set(FxBox2A, ColourYellow, 21))
+	f.Selected[FxBox2A] = ColourYellow
+	f.Active[0] = SideB
+	assert.Nil(t, SetFxBoxes(p, f))
+	return p
+}
+
+func TestToText(t *testing.T) {
+	tx, err := ToText(textPatch(t))
+	assert.Nil(t, err)
+	assert.Equal(t, "sparse", tx.Encoding)
+	assert.Equal(t, `Say "hi" #1`, tx.Name)
+	assert.Equal(t, 0, tx.Chain[0])
+	assert.Equal(t, 5, tx.Chain[3])
+	assert.Equal(t, Value("true"), tx.Params["booster"]["od_ds_on_off"])
+	assert.Equal(t, Value("10"), tx.Params["booster"]["od_ds_type"])
+	assert.Equal(t, Value("19"), tx.Params["mod"]["fx1_fx_type"])
+	assert.Equal(t, Value("33"), tx.Params["mod"]["fx1_phaser_rate"])
+	assert.Equal(t, Value("21"), tx.Params["fxbox"]["fxbox_asgn_fx2a_y"])
+	assert.Equal(t, Value("yellow"), tx.Params["fxbox"]["fxbox_sel_fx2a"])
+	assert.Equal(t, Value("b"), tx.Params["fxbox"]["fx_active_ab_fx1"])
+	assert.Equal(t, Value("1"), tx.Params["patch"]["chain_ptn"])
+
+	_, ok := tx.Params["patch"]["patch_name1"]
+	assert.False(t, ok)
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	p := textPatch(t)
+	buf := bytes.Buffer{}
+	assert.Nil(t, WriteJSON(&buf, p))
+	assert.True(t, strings.Contains(buf.String(), `"fx1_phaser_rate": 33`))
+	assert.True(t, strings.Contains(buf.String(), `"od_ds_on_off": true`))
+
+	r, err := ReadJSON(&buf)
+	assert.Nil(t, err)
+	assert.True(t, Equal(p, r))
+}
+
+func TestValueMarshalJSON(t *testing.T) {
+	cases := map[Value]string{
+		"33":    `33`,
+		"-5":    `-5`,
+		"true":  `true`,
+		"+5":    `"+5"`,
+		"0123":  `"0123"`,
+		"-0":    `"-0"`,
+		"t_wah": `"t_wah"`,
+	}
+	for v, exp := range cases {
+		b, err := v.MarshalJSON()
+		assert.Nil(t, err)
+		assert.Equal(t, exp, string(b))
+	}
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	p := textPatch(t)
+	buf := bytes.Buffer{}
+	assert.Nil(t, WriteYAML(&buf, p))
+	assert.True(t, strings.Contains(buf.String(), "\n    fx1_phaser_rate: 33\n"))
+
+	r, err := ReadYAML(&buf)
+	assert.Nil(t, err)
+	assert.True(t, Equal(p, r))
+}
+
+func TestReadYAMLHandEdited(t *testing.T) {
+	doc := `# A hand written patch
+encoding: sparse
+name: 'Rock ''n'' roll'  # quoted
+params:
+    booster:
+        od_ds_type: "15"
+        od_ds_drive: 70
+
+    mod:
+        fx1_fx_type: 1
+`
+	p, err := ReadYAML(strings.NewReader(doc))
+	assert.Nil(t, err)
+
+	n, err := Name(p)
+	assert.Nil(t, err)
+	assert.Equal(t, "Rock 'n' roll", n)
+
+	v, err := EffectBooster.Type(p)
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint7(15), v)
+
+	d, err := EffectBooster.GetFor(p, "", "drive")
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint14(70), d)
+}
+
+func TestReadTextErrors(t *testing.T) {
+	//Type names aren't mapped to values, only numbers are read.
+	_, err := ReadYAML(strings.NewReader("params:\n  mod:\n    fx1_fx_type: phaser\n"))
+	assert.Equal(t, TextError{Param: "fx1_fx_type", Value: "phaser"}, err)
+
+	_, err = ReadYAML(strings.NewReader("params:\n  mod:\n    fx1_fx_type: 1\n  fx:\n    fx1_fx_type: 2\n"))
+	assert.Equal(t, TextDuplicateError("fx1_fx_type"), err)
+
+	_, err = ReadYAML(strings.NewReader("params:\n  mod:\n    fx1_bogus: 1\n"))
+	assert.Equal(t, TextError{Param: "fx1_bogus"}, err)
+
+	_, err = ReadYAML(strings.NewReader("params:\n  mod:\n     - 1\n"))
+	assert.Equal(t, YAMLError{3, ErrYAMLSyntax}, err)
+
+	for _, chain := range []string{"[amp,]", "[ , ]", "[amp, , delay]"} {
+		_, err = ReadYAML(strings.NewReader("chain: " + chain + "\n"))
+		assert.Equal(t, YAMLError{1, ErrYAMLSyntax}, err)
+	}
+
+	_, err = ReadJSON(strings.NewReader(`{"encoding": "cassette"}`))
+	assert.Equal(t, ErrUnknownEncoding, err)
+}
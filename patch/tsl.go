@@ -0,0 +1,192 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+/*
+Boss Tone Studio liveset files (.tsl) are JSON, laid out as in data/tsl-boilerplate.txt.
+The liveset is described by liveSetData and each entry of patchList holds the parameters
+of a patch by their TSL map name. See data/tsl-map.csv.
+Values are read as numbers, decimal strings or 0x prefixed hex strings, and written as numbers
+like the boilerplate has them.
+The device string Boss Tone Studio writes for a Katana hasn't been confirmed, the boilerplate
+has "GT". So it isn't checked when reading, and "KATANA" is written as a best effort.
+*/
+const (
+	tslVersion   = "1.0.0"
+	tslDeviceMkI = "KATANA"
+)
+
+var ErrNoTslPatches = errors.New("Liveset has no patches")
+
+//Parameters of a liveset that aren't in the TSL map, as "patch index: name".
+//ReadTsl returns them along with the patches, which don't have these parameters.
+type TslUnknownError []string
+
+func (e TslUnknownError) Error() string {
+	return "Unknown liveset parameters " + strings.Join(e, ", ")
+}
+
+//Liveset wide params of data/tsl-boilerplate.txt, which aren't patch memory.
+var tslLiveSetParams = map[string]bool{
+	"currentPatchNo":     true,
+	"prevCurrentPatchNo": true,
+	"pitch_detection":    true,
+	"send_return_adjust": true,
+	"patchCategoryName":  true,
+	"patchname":          true,
+}
+
+func init() {
+	for i := 0; i < 12; i++ {
+		tslLiveSetParams[fmt.Sprintf("comp_name%d", i)] = true
+	}
+}
+
+type tslFile struct {
+	Device      string     `json:"device"`
+	LiveSetData tslLiveSet `json:"liveSetData"`
+	PatchList   []tslPatch `json:"patchList"`
+	Version     string     `json:"version"`
+}
+
+type tslLiveSet struct {
+	OrderNumber int     `json:"orderNumber"`
+	Path        *string `json:"path"`
+	Name        string  `json:"name"`
+	ID          string  `json:"id"`
+	URL         *string `json:"url"`
+	Image       string  `json:"image,omitempty"`
+}
+
+type tslPatch struct {
+	TcPatch      bool             `json:"tcPatch"`
+	LiveSetID    string           `json:"liveSetId"`
+	ID           string           `json:"id"`
+	Note         *string          `json:"note"`
+	Params       map[string]Value `json:"params"`
+	OrderNumber  int              `json:"orderNumber"`
+	PatchID      *string          `json:"patchID"`
+	LogPatchName *string          `json:"logPatchName"`
+	PatchNo      *int             `json:"patchNo"`
+	Category     string           `json:"category"`
+	Name         string           `json:"name"`
+}
+
+//Reads all patches of a liveset. Patches use the dense encoding so nothing is lost.
+//Parameters that aren't in the TSL map are skipped, they're returned as TslUnknownError along with the patches.
+func ReadTsl(r io.Reader) ([]Patch, error) {
+	f := tslFile{}
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, err
+	}
+
+	var (
+		ps      []Patch
+		unknown TslUnknownError
+	)
+	for i, tp := range f.PatchList {
+		p := NewDense()
+		for _, name := range sortedValues(tp.Params) {
+			v := tp.Params[name]
+			param, ok := SchemaAll.Param(name)
+			if !ok {
+				if !tslLiveSetParams[name] {
+					unknown = append(unknown, fmt.Sprintf("%d: %s", i, name))
+				}
+				continue
+			}
+
+			n, err := parseTslValue(string(v))
+			if err != nil {
+				return nil, TextError{Param: name, Value: string(v)}
+			}
+			if _, err := Set(p, param, libktn.Uint14(n)); err != nil {
+				return nil, TextError{Param: name, Value: string(v)}
+			}
+		}
+		ps = append(ps, p)
+	}
+
+	if len(ps) == 0 {
+		return nil, ErrNoTslPatches
+	}
+	if unknown != nil {
+		return ps, unknown
+	}
+	return ps, nil
+}
+
+//Writes patches as a liveset with the given name.
+func WriteTsl(w io.Writer, name string, ps []Patch) error {
+	f := tslFile{
+		Device:      tslDeviceMkI,
+		LiveSetData: tslLiveSet{OrderNumber: 1, Name: name},
+		PatchList:   make([]tslPatch, 0, len(ps)),
+		Version:     tslVersion,
+	}
+
+	for i, p := range ps {
+		s := SchemaOf(p.Encoding())
+
+		tp := tslPatch{Params: map[string]Value{}, OrderNumber: i + 1}
+		tp.Name, _ = Name(p)
+		for _, param := range s.Supported() {
+			v, err := Get(p, param)
+			if err == ErrDiscardedOffset {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			tp.Params[param.Name] = Value(strconv.Itoa(int(v)))
+		}
+		f.PatchList = append(f.PatchList, tp)
+	}
+
+	b, err := json.MarshalIndent(f, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+//Reads all patches of a .tsl file.
+func ReadTslFile(path string) ([]Patch, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadTsl(f)
+}
+
+//Writes patches to a .tsl file.
+func WriteTslFile(path, name string, ps []Patch) error {
+	b := bytes.Buffer{}
+	if err := WriteTsl(&b, name, ps); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b.Bytes(), 0644)
+}
+
+//Parses a decimal or 0x prefixed hex value. Unlike base 0 of ParseUint,
+//a leading zero is still decimal and 0b, 0o and underscores aren't accepted.
+func parseTslValue(s string) (uint64, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return strconv.ParseUint(s[2:], 16, 14)
+	}
+	return strconv.ParseUint(s, 10, 14)
+}
@@ -0,0 +1,67 @@
+package patch
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+func TestTslRoundTrip(t *testing.T) {
+	a := NewSparse()
+	_, err := SetName(a, "First")
+	assert.Nil(t, err)
+	assert.Nil(t, EffectMod.SetFor(a, "phaser", "rate", 12))
+
+	b := NewDense()
+	_, err = SetName(b, "Second")
+	assert.Nil(t, err)
+
+	buf := bytes.Buffer{}
+	assert.Nil(t, WriteTsl(&buf, "Set", []Patch{a, b}))
+	assert.True(t, strings.Contains(buf.String(), `"device": "KATANA"`))
+	assert.True(t, strings.Contains(buf.String(), `"patchList": [`))
+
+	ps, err := ReadTsl(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ps))
+	assert.True(t, Equal(a, ps[0]))
+	assert.True(t, Equal(b, ps[1]))
+}
+
+func TestReadTslValues(t *testing.T) {
+	//The layout of data/tsl-boilerplate.txt.
+	doc := `{"device": "KATANA", "liveSetData": {"name": "x", "id": "1"}, "patchList": [{"liveSetId": "1", "params": {
+		"patch_name1": "0x41", "patch_name2": "66", "output_select": 1,
+		"currentPatchNo": 0, "patchname": "AB", "comp_name3": 0, "unknown_param": "5"
+	}}], "version": "1.0.0"}`
+	ps, err := ReadTsl(strings.NewReader(doc))
+	assert.Equal(t, TslUnknownError{"0: unknown_param"}, err)
+	assert.Equal(t, 1, len(ps))
+
+	n, err := Name(ps[0])
+	assert.Nil(t, err)
+	assert.Equal(t, "AB", n)
+
+	v, err := OutputSelect(ps[0])
+	assert.Nil(t, err)
+	assert.Equal(t, libktn.Uint7(1), v)
+
+	_, err = ReadTsl(strings.NewReader(`{"patchList": []}`))
+	assert.Equal(t, ErrNoTslPatches, err)
+}
+
+func TestParseTslValue(t *testing.T) {
+	for s, exp := range map[string]uint64{"10": 10, "010": 10, "0x10": 16, "0X7f": 127} {
+		n, err := parseTslValue(s)
+		assert.Nil(t, err)
+		assert.Equal(t, exp, n, s)
+	}
+	for _, s := range []string{"0b1", "0o7", "1_0", "0x", "-1", "0x4000"} {
+		_, err := parseTslValue(s)
+		assert.NotNil(t, err, s)
+	}
+}
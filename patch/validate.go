@@ -0,0 +1,161 @@
+package patch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+var (
+	ErrSwitchValue = errors.New("Switch should be 0 or 1")
+	ErrEnumValue   = errors.New("Value is not one of the known options")
+	ErrNameChar    = errors.New("Patch name should only contain printable ASCII")
+)
+
+//A problem found in a patch, with the parameter it was found at.
+type Issue struct {
+	Param  string
+	Offset libktn.Uint14
+	Err    error
+
+	repair func(p Patch) error
+}
+
+func (i Issue) Error() string {
+	return fmt.Sprintf("%s: %s", i.Param, i.Err)
+}
+
+//Whether Repair knows how to fix the issue.
+func (i Issue) Repairable() bool {
+	return i.repair != nil
+}
+
+//Fixes the issue by resetting the parameter to a value the amp accepts.
+func (i Issue) Repair(p Patch) error {
+	if i.repair == nil {
+		return i.Err
+	}
+	return i.repair(p)
+}
+
+//Checks on single byte parameters, keyed by name.
+var enumChecks = makeEnumChecks()
+
+type enumCheck struct {
+	valid func(v libktn.Uint14) bool
+	err   error
+	fix   byte
+}
+
+func makeEnumChecks() map[string]enumCheck {
+	below := func(n libktn.Uint14) enumCheck {
+		return enumCheck{func(v libktn.Uint14) bool { return v < n }, ErrEnumValue, 0}
+	}
+
+	m := map[string]enumCheck{
+		"fx_active_ab_fx1": below(libktn.Uint14(SideB) + 1),
+		"fx_active_ab_fx2": below(libktn.Uint14(SideB) + 1),
+	}
+
+	for box := FxBox(0); box < NumFxBoxes; box++ {
+		m["fxbox_sel_"+box.String()] = below(libktn.Uint14(NumColours))
+	}
+
+	for i := 1; i <= lenName; i++ {
+		m[fmt.Sprintf("patch_name%d", i)] = enumCheck{
+			func(v libktn.Uint14) bool { return v >= nameMin && v <= nameMax },
+			ErrNameChar, namePad,
+		}
+	}
+
+	return m
+}
+
+func isSwitch(name string) bool {
+	return strings.HasSuffix(name, "_on_off") || strings.HasSuffix(name, "_sw")
+}
+
+//Checks a patch for values that would put the amp in an odd state.
+//This covers switches, known enums, the characters of the patch name and the FX chain,
+//other parameters are only checked to fit their 7 bit bytes.
+//Offsets the encoding discards are not checked.
+func Validate(p Patch) []Issue {
+	return append(validateParams(p), validateChain(p)...)
+}
+
+//Repairs every issue that can be, returning the issues that remain.
+func Repair(p Patch) ([]Issue, error) {
+	for _, i := range Validate(p) {
+		if !i.Repairable() {
+			continue
+		}
+		if err := i.Repair(p); err != nil {
+			return nil, err
+		}
+	}
+	return Validate(p), nil
+}
+
+func validateParams(p Patch) []Issue {
+	var r []Issue
+	for _, param := range SchemaOf(p.Encoding()).Supported() {
+		param := param
+		v, err := Get(p, param)
+		switch {
+		case err == ErrDiscardedOffset:
+			continue
+
+		case err != nil:
+			//Doesn't fit in 7 bit bytes.
+			r = append(r, paramIssue(param, err, paramFix(param)))
+
+		case isSwitch(param.Name) && v > 1:
+			r = append(r, paramIssue(param, ErrSwitchValue, paramFix(param)))
+
+		default:
+			if c, ok := enumChecks[param.Name]; ok && !c.valid(v) {
+				r = append(r, paramIssue(param, c.err, paramFix(param)))
+			}
+		}
+	}
+	return r
+}
+
+//The value a parameter is repaired to, which passes every check on it.
+//Switches are switched on, enums get their fix and other parameters the largest value.
+func paramFix(param Param) []byte {
+	switch c, ok := enumChecks[param.Name]; {
+	case isSwitch(param.Name):
+		return []byte{1}
+	case ok:
+		return []byte{c.fix}
+	}
+
+	max := make([]byte, param.Size)
+	for i := range max {
+		max[i] = 0x7F
+	}
+	return max
+}
+
+func paramIssue(param Param, err error, fix []byte) Issue {
+	return Issue{
+		Param:  param.Name,
+		Offset: param.Offset,
+		Err:    err,
+		repair: func(p Patch) error {
+			_, err := p.WriteBytes(param.Offset, fix)
+			return err
+		},
+	}
+}
+
+//Chains are reported but not repaired, as only reordering a valid chain is safe.
+func validateChain(p Patch) []Issue {
+	if _, err := FxChainOf(p); err != nil {
+		return []Issue{{Param: "fx_chain_position1", Offset: offFxChain, Err: err}}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+func validPatch(t *testing.T, p Patch) Patch {
+	_, err := p.WriteBytes(0, []byte("Clean           "))
+	assert.Nil(t, err)
+	assert.Nil(t, p.SetFxChain(identityChain()))
+	return p
+}
+
+func issueParams(issues []Issue) []string {
+	r := make([]string, len(issues))
+	for i, is := range issues {
+		r[i] = is.Param
+	}
+	return r
+}
+
+func TestValidateClean(t *testing.T) {
+	assert.Equal(t, 0, len(Validate(validPatch(t, NewSparse()))))
+}
+
+func TestValidateIssues(t *testing.T) {
+	p := validPatch(t, NewDense())
+	_, err := p.WriteBytes(3, []byte{0x07})
+	assert.Nil(t, err)
+	_, err = p.WriteBytes(mustParam(t, "od_ds_on_off").Offset, []byte{5})
+	assert.Nil(t, err)
+	_, err = p.WriteBytes(mustParam(t, "delay_f_back").Offset, []byte{0xFF})
+	assert.Nil(t, err)
+	_, err = p.WriteBytes(mustParam(t, "fxbox_sel_fx1a").Offset, []byte{byte(NumColours)})
+	assert.Nil(t, err)
+
+	issues := Validate(p)
+	assert.Equal(t, []string{"patch_name4", "od_ds_on_off", "delay_f_back", "fxbox_sel_fx1a"}, issueParams(issues))
+	assert.Equal(t, ErrNameChar, issues[0].Err)
+	assert.Equal(t, ErrSwitchValue, issues[1].Err)
+	assert.Equal(t, ErrEnumValue, issues[3].Err)
+
+	left, err := Repair(p)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(left))
+	assert.Equal(t, 0, len(Validate(p)))
+
+	n, err := p.GetByte(3)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(' '), byte(n))
+}
+
+func TestRepairOverflow(t *testing.T) {
+	//Each kind is repaired to a value its own check accepts, not just to 7 bits.
+	p := validPatch(t, NewDense())
+	for _, name := range []string{"patch_name3", "od_ds_on_off", "fxbox_sel_fx2b", "delay_f_back"} {
+		_, err := p.WriteBytes(mustParam(t, name).Offset, []byte{0xFF})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 4, len(Validate(p)))
+
+	left, err := Repair(p)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(left))
+	assert.Equal(t, 0, len(Validate(p)))
+
+	for name, exp := range map[string]libktn.Uint14{
+		"patch_name3":    namePad,
+		"od_ds_on_off":   1,
+		"fxbox_sel_fx2b": 0,
+		"delay_f_back":   0x7F,
+	} {
+		v, err := Get(p, mustParam(t, name))
+		assert.Nil(t, err)
+		assert.Equal(t, exp, v, name)
+	}
+}
+
+func TestValidateChain(t *testing.T) {
+	//Patterns aren't known, so they're left alone.
+	p := validPatch(t, NewSparse())
+	_, err := p.WriteBytes(offChainPtn, []byte{3})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(Validate(p)))
+
+	//Broken chains are reported, but left alone.
+	_, err = p.WriteBytes(offFxChain, []byte{1})
+	assert.Nil(t, err)
+	issues := Validate(p)
+	assert.Equal(t, []string{"fx_chain_position1"}, issueParams(issues))
+	assert.Equal(t, ChainBlockError{Block: 0, Count: 0}, issues[0].Err)
+	assert.False(t, issues[0].Repairable())
+	left, err := Repair(p)
+	assert.Nil(t, err)
+	assert.Equal(t, issues[0].Err, left[0].Err)
+}
@@ -0,0 +1,316 @@
+package patch
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+The YAML form of a Text uses a small subset of YAML, which is enough for
+hand editing and keeps this package free of dependencies:
+ - Block mappings indented with spaces.
+ - Plain, single quoted and double quoted scalars.
+ - Flow sequences of scalars, such as the chain.
+ - Comments and blank lines.
+*/
+
+var ErrYAMLSyntax = errors.New("Unsupported YAML syntax")
+
+//Reported with the line number of a YAML syntax error.
+type YAMLError struct {
+	Line int
+	Err  error
+}
+
+func (e YAMLError) Error() string {
+	return fmt.Sprintf("Line %d: %s", e.Line, e.Err)
+}
+
+//Writes a patch as YAML.
+func WriteYAML(w io.Writer, p Patch) error {
+	t, err := ToText(p)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "encoding: %s\n", yamlScalar(t.Encoding))
+	fmt.Fprintf(bw, "name: %s\n", strconv.Quote(t.Name))
+
+	chain := make([]string, len(t.Chain))
+	for i, b := range t.Chain {
+		chain[i] = strconv.Itoa(b)
+	}
+	fmt.Fprintf(bw, "chain: [%s]\n", strings.Join(chain, ", "))
+
+	fmt.Fprintln(bw, "params:")
+	for _, g := range sortedKeys(t.Params) {
+		fmt.Fprintf(bw, "  %s:\n", g)
+		for _, n := range sortedValues(t.Params[g]) {
+			fmt.Fprintf(bw, "    %s: %s\n", n, yamlScalar(string(t.Params[g][n])))
+		}
+	}
+
+	return bw.Flush()
+}
+
+//Reads a patch written by WriteYAML.
+func ReadYAML(r io.Reader) (Patch, error) {
+	doc, err := parseYAML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	t := Text{Params: map[string]map[string]Value{}}
+	for k, v := range doc {
+		var ok bool
+		switch k {
+		case "encoding":
+			t.Encoding, ok = v.(string)
+		case "name":
+			t.Name, ok = v.(string)
+		case "chain":
+			var items []string
+			items, ok = v.([]string)
+			for _, item := range items {
+				n, err := strconv.Atoi(item)
+				if err != nil {
+					return nil, TextError{Param: k, Value: item}
+				}
+				t.Chain = append(t.Chain, n)
+			}
+		case "params":
+			var groups map[string]interface{}
+			groups, ok = v.(map[string]interface{})
+			for g, params := range groups {
+				m, isMap := params.(map[string]interface{})
+				if !isMap {
+					return nil, TextError{Param: g, Value: fmt.Sprint(params)}
+				}
+
+				t.Params[g] = map[string]Value{}
+				for n, pv := range m {
+					s, isStr := pv.(string)
+					if !isStr {
+						return nil, TextError{Param: n, Value: fmt.Sprint(pv)}
+					}
+					t.Params[g][n] = Value(s)
+				}
+			}
+		default:
+			return nil, TextError{Param: k}
+		}
+
+		if !ok {
+			return nil, TextError{Param: k, Value: fmt.Sprint(v)}
+		}
+	}
+
+	return FromText(t)
+}
+
+func sortedKeys(m map[string]map[string]Value) []string {
+	r := make([]string, 0, len(m))
+	for k := range m {
+		r = append(r, k)
+	}
+	sort.Strings(r)
+	return r
+}
+
+func sortedValues(m map[string]Value) []string {
+	r := make([]string, 0, len(m))
+	for k := range m {
+		r = append(r, k)
+	}
+	sort.Strings(r)
+	return r
+}
+
+//Quotes a scalar unless it's made of characters that are always plain.
+func yamlScalar(s string) string {
+	if s == "" {
+		return `""`
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_') {
+			return strconv.Quote(s)
+		}
+	}
+	return s
+}
+
+type yamlLevel struct {
+	indent int
+	m      map[string]interface{}
+}
+
+//Parses the YAML subset into nested maps of strings and string slices.
+func parseYAML(r io.Reader) (map[string]interface{}, error) {
+	root := map[string]interface{}{}
+	stack := []yamlLevel{{indent: -1, m: root}}
+	//Set after a key without a value, the next line has to nest deeper.
+	//The indent of a level is known from its first line.
+	open := true
+
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text := stripComment(s.Text())
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		body := strings.TrimLeft(text, " ")
+		indent := len(text) - len(body)
+		if strings.HasPrefix(body, "\t") || strings.HasPrefix(body, "- ") || body == "---" {
+			return nil, YAMLError{line, ErrYAMLSyntax}
+		}
+
+		if open {
+			if indent <= stack[len(stack)-1].indent {
+				return nil, YAMLError{line, ErrYAMLSyntax}
+			}
+			stack[len(stack)-1].indent = indent
+			open = false
+		}
+		for indent < stack[len(stack)-1].indent {
+			stack = stack[:len(stack)-1]
+		}
+		if indent != stack[len(stack)-1].indent {
+			return nil, YAMLError{line, ErrYAMLSyntax}
+		}
+
+		i := strings.Index(body, ":")
+		if i <= 0 || (i+1 < len(body) && body[i+1] != ' ') {
+			return nil, YAMLError{line, ErrYAMLSyntax}
+		}
+		key := strings.TrimSpace(body[:i])
+		val := strings.TrimSpace(body[i+1:])
+		m := stack[len(stack)-1].m
+
+		if val == "" {
+			//A nested mapping follows.
+			child := map[string]interface{}{}
+			m[key] = child
+			stack = append(stack, yamlLevel{indent: indent, m: child})
+			open = true
+			continue
+		}
+
+		v, err := parseYAMLValue(val)
+		if err != nil {
+			return nil, YAMLError{line, err}
+		}
+		m[key] = v
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func parseYAMLValue(val string) (interface{}, error) {
+	if !strings.HasPrefix(val, "[") {
+		return parseYAMLScalar(val)
+	}
+	if !strings.HasSuffix(val, "]") {
+		return nil, ErrYAMLSyntax
+	}
+
+	r := []string{}
+	inner := strings.TrimSpace(val[1 : len(val)-1])
+	if inner == "" {
+		return r, nil
+	}
+	for _, item := range splitFlow(inner) {
+		s, err := parseYAMLScalar(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, s)
+	}
+	return r, nil
+}
+
+func parseYAMLScalar(s string) (string, error) {
+	switch {
+	case s == "":
+		//Such as the items of [a, ] or [ , ].
+		return "", ErrYAMLSyntax
+
+	case strings.HasPrefix(s, `"`):
+		r, err := strconv.Unquote(s)
+		if err != nil {
+			return "", ErrYAMLSyntax
+		}
+		return r, nil
+
+	case strings.HasPrefix(s, "'"):
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return "", ErrYAMLSyntax
+		}
+		return strings.Replace(s[1:len(s)-1], "''", "'", -1), nil
+
+	case strings.ContainsAny(s[:1], "[]{}&*!|>%@`"):
+		return "", ErrYAMLSyntax
+
+	default:
+		return s, nil
+	}
+}
+
+//Splits flow sequence items on commas outside of quotes.
+func splitFlow(s string) []string {
+	var r []string
+	start := 0
+	q := quoteTracker{}
+	for i := 0; i < len(s); i++ {
+		if !q.next(s[i]) && s[i] == ',' {
+			r = append(r, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(r, s[start:])
+}
+
+//Removes a comment, which starts with a # at the beginning or after a space, outside of quotes.
+func stripComment(s string) string {
+	q := quoteTracker{}
+	for i := 0; i < len(s); i++ {
+		if !q.next(s[i]) && s[i] == '#' && (i == 0 || s[i-1] == ' ') {
+			return s[:i]
+		}
+	}
+	return s
+}
+
+//Keeps track of whether a byte is part of a quoted scalar.
+type quoteTracker struct {
+	quote  byte
+	escape bool
+}
+
+//Whether b is quoted, including the quotes themselves.
+func (q *quoteTracker) next(b byte) bool {
+	switch {
+	case q.escape:
+		q.escape = false
+	case q.quote == '"' && b == '\\':
+		q.escape = true
+	case q.quote != 0:
+		if b == q.quote {
+			q.quote = 0
+		}
+	case b == '"' || b == '\'':
+		q.quote = b
+	default:
+		return false
+	}
+	return true
+}
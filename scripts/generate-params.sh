@@ -0,0 +1,28 @@
+#!/bin/bash
+#
+# Take the tsl parameter map and generate a Go parameter table.
+# $1 = Go variable name
+# $2 = tsl parameter map CSV file
+# $3 = description for the doc comment
+
+OLDIFS=$IFS
+IFS=";"
+
+echo "//Code generated by scripts/generate-params.sh. DO NOT EDIT."
+echo ""
+echo "package patch"
+echo ""
+echo "//$3"
+echo "var $1 = []Param{"
+
+while read tslname offset size relevant
+ do
+    supported="false"
+    if(($relevant == 1)); then
+        supported="true"
+    fi
+    echo "	Param{Name: \"$tslname\", Offset: $offset, Size: $size, Supported: $supported},"
+ done < $2
+
+echo "}"
+IFS=$OLDIFS
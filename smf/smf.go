@@ -0,0 +1,455 @@
+package smf
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sort"
+
+	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+var (
+	ErrBadHeader      = errors.New("Standard MIDI File should start with an MThd chunk")
+	ErrFormat         = errors.New("Only Standard MIDI File format 0 and 1 are supported")
+	ErrTrackIndex     = errors.New("Track index is out of range")
+	ErrBadEvent       = errors.New("Track contains an invalid event")
+	ErrRunningStatus  = errors.New("Track uses running status without a previous status byte")
+	ErrBadVarLen      = errors.New("Variable length quantity is too long")
+	ErrBadTrackLength = errors.New("Track chunk length doesn't match its events")
+	ErrChunkLength    = errors.New("Chunk is longer than supported")
+)
+
+//Event status values for non-channel events.
+const (
+	StatusSysex  = byte(0xF0)
+	StatusEscape = byte(0xF7)
+	StatusMeta   = byte(0xFF)
+
+	MetaEndOfTrack = byte(0x2F)
+)
+
+const (
+	headerLen = 6
+	maxVarLen = 4
+	sysexEnd  = byte(0xF7)
+
+	//Far beyond any file of patches, but keeps a bad header from allocating gigabytes.
+	maxChunkLen = 16 << 20
+)
+
+var (
+	chunkHeader = []byte("MThd")
+	chunkTrack  = []byte("MTrk")
+)
+
+//A single event in a track.
+//For channel events Data holds the status and data bytes.
+//For sysex events Data holds the complete message, including 0xF0 and 0xF7,
+//with any continuation packets already joined.
+//For escape and meta events Data holds the payload only.
+type Event struct {
+	Tick   uint32
+	Status byte
+	Meta   byte
+	Data   []byte
+}
+
+//A track is a list of events, ordered by tick.
+type Track []Event
+
+//A Standard MIDI File.
+type File struct {
+	Format   uint16
+	Division uint16
+	Tracks   []Track
+}
+
+//A sysex message found in a file, along with its position.
+type SysexEvent struct {
+	Track   int
+	Tick    uint32
+	Message *sysex.SysexMessage
+}
+
+//Creates a format 0 file with a single empty track.
+func New(division uint16) *File {
+	return &File{Format: 0, Division: division, Tracks: []Track{Track{}}}
+}
+
+//Reads a Standard MIDI File.
+func Read(r io.Reader) (*File, error) {
+	br := bufio.NewReader(r)
+
+	id, data, err := readChunk(br)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(id, chunkHeader) || len(data) < headerLen {
+		return nil, ErrBadHeader
+	}
+
+	f := &File{
+		Format:   binary.BigEndian.Uint16(data[0:2]),
+		Division: binary.BigEndian.Uint16(data[4:6]),
+	}
+	if f.Format > 1 {
+		return nil, ErrFormat
+	}
+
+	for {
+		id, data, err = readChunk(br)
+		if err == io.EOF {
+			return f, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		//Unknown chunks should be skipped, as per the spec.
+		if !bytes.Equal(id, chunkTrack) {
+			continue
+		}
+
+		t, err := parseTrack(data)
+		if err != nil {
+			return nil, err
+		}
+		f.Tracks = append(f.Tracks, t)
+	}
+}
+
+//Reads a Standard MIDI File from disk.
+func ReadFile(path string) (*File, error) {
+	fh, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+
+	return Read(fh)
+}
+
+//Writes the file in Standard MIDI File format.
+//An end of track event is added to tracks that lack one.
+func (f *File) Write(w io.Writer) error {
+	if f.Format > 1 {
+		return ErrFormat
+	}
+
+	h := make([]byte, headerLen)
+	binary.BigEndian.PutUint16(h[0:2], f.Format)
+	binary.BigEndian.PutUint16(h[2:4], uint16(len(f.Tracks)))
+	binary.BigEndian.PutUint16(h[4:6], f.Division)
+	if err := writeChunk(w, chunkHeader, h); err != nil {
+		return err
+	}
+
+	for _, t := range f.Tracks {
+		if err := writeChunk(w, chunkTrack, t.encode()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Writes the file to disk.
+func (f *File) WriteFile(path string) error {
+	b := bytes.Buffer{}
+	if err := f.Write(&b); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b.Bytes(), 0644)
+}
+
+//Parses all sysex events in the file that are valid Katana messages.
+//Other sysex messages are skipped.
+func (f *File) Sysex() []SysexEvent {
+	var r []SysexEvent
+	for ti, t := range f.Tracks {
+		for _, e := range t {
+			if e.Status != StatusSysex {
+				continue
+			}
+
+			m, err := sysex.Parse(e.Data)
+			if err != nil {
+				continue
+			}
+			r = append(r, SysexEvent{Track: ti, Tick: e.Tick, Message: m})
+		}
+	}
+	return r
+}
+
+//Inserts sysex messages into a track at the given tick.
+//They are placed after any events already at that tick.
+func (f *File) AddSysex(track int, tick uint32, msgs []sysex.SysexMessage) error {
+	if track < 0 || track >= len(f.Tracks) {
+		return ErrTrackIndex
+	}
+
+	evs := make([]Event, len(msgs))
+	for i := range msgs {
+		b, err := msgs[i].Sysex()
+		if err != nil {
+			return err
+		}
+		evs[i] = Event{Tick: tick, Status: StatusSysex, Data: b}
+	}
+
+	f.Tracks[track] = f.Tracks[track].insert(evs)
+	return nil
+}
+
+//Inserts the commands to upload a patch to a region into a track at the given tick.
+func (f *File) AddPatch(track int, tick uint32, p patch.Patch, region libktn.Uint14) error {
+	msgs, err := patch.Commands(p, region, patch.DefaultChunkSize)
+	if err != nil {
+		return err
+	}
+	return f.AddSysex(track, tick, msgs)
+}
+
+func (t Track) insert(evs []Event) Track {
+	if len(evs) == 0 {
+		return t
+	}
+	tick := evs[0].Tick
+
+	//End of track has to stay last, so it's moved when we insert beyond it.
+	var eot *Event
+	if n := len(t); n > 0 && t[n-1].isEndOfTrack() {
+		e := t[n-1]
+		if e.Tick < tick {
+			e.Tick = tick
+		}
+		eot = &e
+		t = t[:n-1]
+	}
+
+	i := sort.Search(len(t), func(i int) bool { return t[i].Tick > tick })
+	r := make(Track, 0, len(t)+len(evs)+1)
+	r = append(r, t[:i]...)
+	r = append(r, evs...)
+	r = append(r, t[i:]...)
+	if eot != nil {
+		r = append(r, *eot)
+	}
+	return r
+}
+
+func (t Track) encode() []byte {
+	b := bytes.Buffer{}
+	var last uint32
+	for _, e := range t {
+		writeVarLen(&b, e.Tick-last)
+		last = e.Tick
+
+		switch e.Status {
+		case StatusSysex:
+			//Length excludes the status byte, which is part of the data.
+			b.WriteByte(StatusSysex)
+			writeVarLen(&b, uint32(len(e.Data)-1))
+			b.Write(e.Data[1:])
+		case StatusEscape:
+			b.WriteByte(StatusEscape)
+			writeVarLen(&b, uint32(len(e.Data)))
+			b.Write(e.Data)
+		case StatusMeta:
+			b.WriteByte(StatusMeta)
+			b.WriteByte(e.Meta)
+			writeVarLen(&b, uint32(len(e.Data)))
+			b.Write(e.Data)
+		default:
+			b.Write(e.Data)
+		}
+	}
+
+	if len(t) == 0 || !t[len(t)-1].isEndOfTrack() {
+		writeVarLen(&b, 0)
+		b.Write([]byte{StatusMeta, MetaEndOfTrack, 0})
+	}
+	return b.Bytes()
+}
+
+func (e *Event) isEndOfTrack() bool {
+	return e.Status == StatusMeta && e.Meta == MetaEndOfTrack
+}
+
+func parseTrack(data []byte) (Track, error) {
+	var (
+		t       Track
+		tick    uint32
+		running byte
+		pending = -1 //Sysex message waiting for continuation packets.
+	)
+
+	r := bytes.NewReader(data)
+	for r.Len() > 0 {
+		delta, err := readVarLen(r)
+		if err != nil {
+			return nil, err
+		}
+		tick += delta
+
+		s, err := r.ReadByte()
+		if err != nil {
+			return nil, ErrBadTrackLength
+		}
+
+		switch {
+		case s == StatusSysex || s == StatusEscape:
+			running = 0
+			d, err := readVarData(r)
+			if err != nil {
+				return nil, err
+			}
+
+			if s == StatusEscape && pending >= 0 {
+				//Continuation packet for an earlier sysex event.
+				t[pending].Data = append(t[pending].Data, d...)
+			} else if s == StatusSysex {
+				t = append(t, Event{Tick: tick, Status: StatusSysex, Data: append([]byte{StatusSysex}, d...)})
+				pending = len(t) - 1
+			} else {
+				t = append(t, Event{Tick: tick, Status: StatusEscape, Data: d})
+			}
+
+			//A packet ending with 0xF7 completes the message.
+			if pending >= 0 && t[pending].Data[len(t[pending].Data)-1] == sysexEnd {
+				pending = -1
+			}
+
+		case s == StatusMeta:
+			running = 0
+			mt, err := r.ReadByte()
+			if err != nil {
+				return nil, ErrBadTrackLength
+			}
+			d, err := readVarData(r)
+			if err != nil {
+				return nil, err
+			}
+			t = append(t, Event{Tick: tick, Status: StatusMeta, Meta: mt, Data: d})
+
+		default:
+			if s < 0x80 {
+				//Running status, this was the first data byte.
+				if running == 0 {
+					return nil, ErrRunningStatus
+				}
+				r.UnreadByte()
+				s = running
+			} else if s >= 0xF0 {
+				return nil, ErrBadEvent
+			}
+			running = s
+
+			d := make([]byte, channelDataLen(s)+1)
+			d[0] = s
+			if _, err := io.ReadFull(r, d[1:]); err != nil {
+				return nil, ErrBadTrackLength
+			}
+			t = append(t, Event{Tick: tick, Status: s, Data: d})
+		}
+
+		//Other events between sysex packets end the chance of continuation.
+		if s != StatusSysex && s != StatusEscape {
+			pending = -1
+		}
+	}
+
+	return t, nil
+}
+
+func channelDataLen(status byte) int {
+	switch status & 0xF0 {
+	case 0xC0, 0xD0:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func readChunk(r io.Reader) ([]byte, []byte, error) {
+	h := make([]byte, 8)
+	if _, err := io.ReadFull(r, h); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, nil, ErrBadTrackLength
+		}
+		return nil, nil, err
+	}
+
+	n := int64(binary.BigEndian.Uint32(h[4:8]))
+	if n > maxChunkLen {
+		return nil, nil, ErrChunkLength
+	}
+
+	//Grow with what's actually read, rather than trusting the header.
+	d := bytes.Buffer{}
+	if _, err := io.CopyN(&d, r, n); err != nil {
+		return nil, nil, ErrBadTrackLength
+	}
+	return h[:4], d.Bytes(), nil
+}
+
+func writeChunk(w io.Writer, id, data []byte) error {
+	h := make([]byte, 8)
+	copy(h, id)
+	binary.BigEndian.PutUint32(h[4:8], uint32(len(data)))
+	if _, err := w.Write(h); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+func readVarData(r *bytes.Reader) ([]byte, error) {
+	l, err := readVarLen(r)
+	if err != nil {
+		return nil, err
+	}
+	if int64(l) > int64(r.Len()) {
+		return nil, ErrBadTrackLength
+	}
+
+	d := make([]byte, l)
+	r.Read(d)
+	return d, nil
+}
+
+//Reads a variable length quantity, 7 bits per byte with the MSB as continuation flag.
+func readVarLen(r io.ByteReader) (uint32, error) {
+	var v uint32
+	for i := 0; i < maxVarLen; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, ErrBadTrackLength
+		}
+
+		v = v<<7 | uint32(b&0x7F)
+		if b&0x80 == 0 {
+			return v, nil
+		}
+	}
+	return 0, ErrBadVarLen
+}
+
+func writeVarLen(b *bytes.Buffer, v uint32) {
+	buf := make([]byte, 0, maxVarLen)
+	buf = append(buf, byte(v&0x7F))
+	for v >>= 7; v > 0; v >>= 7 {
+		buf = append(buf, byte(v&0x7F)|0x80)
+	}
+
+	//Bytes were collected least significant first.
+	for i := len(buf) - 1; i >= 0; i-- {
+		b.WriteByte(buf[i])
+	}
+}
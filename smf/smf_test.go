@@ -0,0 +1,128 @@
+package smf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stvp/assert"
+
+	"github.com/katana-dev/lib-katana/patch"
+	"github.com/katana-dev/lib-katana/sysex"
+)
+
+var (
+	//Katana command for the panel name, split in a packet and a continuation.
+	cmdPanel = []byte{0xF0, 0x41, 0x00, 0x00, 0x00, 0x00, 0x33, 0x12, 0x60, 0x00, 0x00, 0x00, 0x4B, 0x41, 0x54, 0x41, 0x7F, 0xF7}
+)
+
+func TestVarLen(t *testing.T) {
+	var (
+		valid = map[uint32][]byte{
+			0x00:       []byte{0x00},
+			0x7F:       []byte{0x7F},
+			0x80:       []byte{0x81, 0x00},
+			0x2000:     []byte{0xC0, 0x00},
+			0x3FFF:     []byte{0xFF, 0x7F},
+			0x0FFFFFFF: []byte{0xFF, 0xFF, 0xFF, 0x7F},
+		}
+	)
+
+	for v, in := range valid {
+		b := bytes.Buffer{}
+		writeVarLen(&b, v)
+		assert.Equal(t, in, b.Bytes())
+
+		r, e := readVarLen(bytes.NewReader(in))
+		assert.Nil(t, e)
+		assert.Equal(t, v, r)
+	}
+
+	_, e := readVarLen(bytes.NewReader([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0x7F}))
+	assert.Equal(t, ErrBadVarLen, e)
+}
+
+func TestReadContinuation(t *testing.T) {
+	track := []byte{
+		//Note on, then running status note off.
+		0x00, 0x90, 0x40, 0x7F,
+		0x10, 0x40, 0x00,
+	}
+	//First packet without the footer.
+	track = append(track, 0x20, 0xF0, byte(10))
+	track = append(track, cmdPanel[1:11]...)
+	//Continuation packet with the rest.
+	track = append(track, 0x05, 0xF7, byte(len(cmdPanel)-11))
+	track = append(track, cmdPanel[11:]...)
+	//End of track.
+	track = append(track, 0x00, 0xFF, 0x2F, 0x00)
+
+	file := []byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96}
+	file = append(file, 'M', 'T', 'r', 'k', 0, 0, 0, byte(len(track)))
+	file = append(file, track...)
+
+	f, e := Read(bytes.NewReader(file))
+	assert.Nil(t, e)
+	assert.Equal(t, uint16(96), f.Division)
+	assert.Equal(t, 1, len(f.Tracks))
+	assert.Equal(t, 4, len(f.Tracks[0]))
+	assert.Equal(t, []byte{0x90, 0x40, 0x00}, f.Tracks[0][1].Data)
+	assert.Equal(t, cmdPanel, f.Tracks[0][2].Data)
+	assert.Equal(t, uint32(0x30), f.Tracks[0][2].Tick)
+
+	s := f.Sysex()
+	assert.Equal(t, 1, len(s))
+	assert.Equal(t, uint32(0x30), s[0].Tick)
+	assert.Equal(t, sysex.OpCommand, int(s[0].Message.Op))
+	assert.Equal(t, []byte("KATA"), s[0].Message.Data)
+}
+
+func TestWritePatch(t *testing.T) {
+	p := patch.NewSparse()
+	_, e := p.WriteBytes(0, []byte("Chorus"))
+	assert.Nil(t, e)
+
+	f := New(480)
+	assert.Nil(t, f.AddPatch(0, 1920, p, sysex.CH1Region))
+	assert.Nil(t, f.AddSysex(0, 0, []sysex.SysexMessage{sysex.MakeIdRequest()}))
+	assert.Equal(t, ErrTrackIndex, f.AddSysex(1, 0, nil))
+
+	b := bytes.Buffer{}
+	assert.Nil(t, f.Write(&b))
+
+	r, e := Read(&b)
+	assert.Nil(t, e)
+	s := r.Sysex()
+	assert.Equal(t, sysex.OpIdRequest, int(s[0].Message.Op))
+	assert.Equal(t, uint32(0), s[0].Tick)
+
+	var msgs []*sysex.SysexMessage
+	for _, ev := range s[1:] {
+		assert.Equal(t, uint32(1920), ev.Tick)
+		msgs = append(msgs, ev.Message)
+	}
+	rp, e := patch.FromMessages(patch.EncSparse, sysex.CH1Region, msgs)
+	assert.Nil(t, e)
+	assert.Equal(t, patch.Bytes(p), patch.Bytes(rp))
+
+	//End of track is written once and kept last.
+	last := r.Tracks[0][len(r.Tracks[0])-1]
+	assert.True(t, last.isEndOfTrack())
+	assert.Equal(t, uint32(1920), last.Tick)
+}
+
+func TestReadErrors(t *testing.T) {
+	_, e := Read(bytes.NewReader([]byte{'M', 'T', 'r', 'k', 0, 0, 0, 0}))
+	assert.Equal(t, ErrBadHeader, e)
+
+	_, e = Read(bytes.NewReader([]byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 2, 0, 1, 0, 96}))
+	assert.Equal(t, ErrFormat, e)
+
+	_, e = Read(bytes.NewReader([]byte{'M', 'T', 'h', 'd', 0, 0, 0, 6, 0, 0, 0, 1, 0, 96, 'M', 'T', 'r', 'k', 0, 0, 0, 3, 0x00, 0x40, 0x00}))
+	assert.Equal(t, ErrRunningStatus, e)
+
+	_, e = Read(bytes.NewReader([]byte{'M', 'T', 'h', 'd', 0xFF, 0xFF, 0xFF, 0xFF, 0, 0}))
+	assert.Equal(t, ErrChunkLength, e)
+
+	_, e = Read(bytes.NewReader([]byte{'M', 'T', 'h', 'd', 0x00, 0xFF, 0xFF, 0xFF, 0, 0}))
+	assert.Equal(t, ErrBadTrackLength, e)
+}
@@ -0,0 +1,73 @@
+package sysex
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+//A golden message from testdata/conformance.txt.
+type golden struct {
+	line  int
+	frame []byte
+	desc  string
+}
+
+func readGolden() ([]golden, error) {
+	f, err := os.Open("testdata/conformance.txt")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var gs []golden
+	s := bufio.NewScanner(f)
+	for n := 1; s.Scan(); n++ {
+		l := strings.TrimSpace(s.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(l, " = ", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("conformance.txt:%d: missing description", n)
+		}
+		b, err := hex.DecodeString(strings.Replace(parts[0], " ", "", -1))
+		if err != nil {
+			return nil, fmt.Errorf("conformance.txt:%d: %s", n, err)
+		}
+		gs = append(gs, golden{line: n, frame: b, desc: parts[1]})
+	}
+	return gs, s.Err()
+}
+
+func TestConformance(t *testing.T) {
+	gs, err := readGolden()
+	assert.Nil(t, err)
+	assert.True(t, len(gs) > 0)
+
+	for _, g := range gs {
+		m, err := Parse(g.frame)
+		if err != nil {
+			t.Errorf("conformance.txt:%d: %s", g.line, err)
+			continue
+		}
+		if d := Describe(g.frame); d != g.desc {
+			t.Errorf("conformance.txt:%d: decoded as %q", g.line, d)
+		}
+
+		b, err := m.Sysex()
+		if err != nil {
+			t.Errorf("conformance.txt:%d: %s", g.line, err)
+			continue
+		}
+		if FormatHex(b) != FormatHex(g.frame) {
+			t.Errorf("conformance.txt:%d: encoded as %s", g.line, FormatHex(b))
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package sysex
+
+import (
+	"fmt"
+	"strings"
+
+	libktn "github.com/katana-dev/lib-katana"
+)
+
+//Short names of the known regions, as used in descriptions.
+var RegionNames = map[libktn.Uint14]string{
+	CH1Region:   "CH1",
+	CH2Region:   "CH2",
+	CH3Region:   "CH3",
+	CH4Region:   "CH4",
+	PanelRegion: "PANEL",
+}
+
+//Names the memory a query or command covers, such as the parameters of a patch.
+//Data is nil for queries. Returns "" when the namer doesn't know the memory.
+type Namer func(a Address, size int, data []byte) string
+
+var namers []Namer
+
+//Adds a Namer for descriptions. The patch package registers the TSL map this way.
+func RegisterNamer(n Namer) {
+	namers = append(namers, n)
+}
+
+//Formats bytes as space separated hex.
+func FormatHex(b []byte) string {
+	s := make([]string, len(b))
+	for i, v := range b {
+		s[i] = fmt.Sprintf("%02X", v)
+	}
+	return strings.Join(s, " ")
+}
+
+func (a Address) String() string {
+	b, err := a.Sysex()
+	if err != nil {
+		return fmt.Sprintf("%d:%d", a.Region, a.Offset)
+	}
+	return FormatHex(b)
+}
+
+//Describes what the address points at, such as "CH2 patch_name1..16".
+func describeAddress(a Address, size int, data []byte) string {
+	d := []string{}
+	if n, ok := RegionNames[a.Region]; ok {
+		d = append(d, n)
+	}
+	for _, n := range namers {
+		if s := n(a, size, data); s != "" {
+			d = append(d, s)
+			break
+		}
+	}
+
+	if len(d) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", strings.Join(d, " "))
+}
+
+//Renders a message in a readable form, like:
+//
+//	DT1 dev=00 addr=10 02 07 20 (CH2 fx_chain_position1..4) data=[01 02 03 04]
+func (m SysexMessage) String() string {
+	switch m.Op {
+	case OpIdRequest:
+		return fmt.Sprintf("ID request dev=%02X", m.DeviceId)
+
+	case OpIdResponse:
+		s := fmt.Sprintf("ID response dev=%02X firmware=%s", m.DeviceId, FormatHex(m.FirmwareVer))
+		if c, err := m.Capability(); err == nil {
+			s += fmt.Sprintf(" (%s)", c.Name)
+		}
+		return s
+
+	case OpQuery:
+		return fmt.Sprintf("RQ1 dev=%02X addr=%s%s size=%d",
+			m.DeviceId, m.Address, describeAddress(m.Address, int(m.Size), nil), m.Size)
+
+	case OpCommand:
+		return fmt.Sprintf("DT1 dev=%02X addr=%s%s data=[%s]",
+			m.DeviceId, m.Address, describeAddress(m.Address, len(m.Data), m.Data), FormatHex(m.Data))
+
+	default:
+		return fmt.Sprintf("Unknown op %d dev=%02X", m.Op, m.DeviceId)
+	}
+}
+
+//Parses and describes a raw frame, including whether the checksum matches.
+//Frames that can't be parsed are described by their error and bytes.
+func Describe(frame []byte) string {
+	m, err := Parse(frame)
+	if m == nil {
+		return fmt.Sprintf("invalid: %s [%s]", err, FormatHex(frame))
+	}
+
+	s := m.String()
+	switch {
+	case err == ErrBadChecksum:
+		s += " checksum bad"
+	case m.Op == OpQuery || m.Op == OpCommand:
+		s += " checksum ok"
+	}
+	return s
+}
@@ -0,0 +1,42 @@
+package sysex
+
+import (
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+func TestAddressString(t *testing.T) {
+	assert.Equal(t, "10 02 07 20", Address{CH2Region, 928}.String())
+	assert.Equal(t, "60 00 00 10", Address{PanelRegion, 16}.String())
+}
+
+func TestMessageString(t *testing.T) {
+	assert.Equal(t, "ID request dev=7F", MakeIdRequest().String())
+
+	r := SysexMessage{Op: OpIdResponse, FirmwareVer: []byte{0x01, 0x00, 0x00, 0x00}}
+	assert.Equal(t, "ID response dev=00 firmware=01 00 00 00 (Katana v1.x)", r.String())
+
+	q := MakeQuery(Address{PanelRegion, 0}, 4)
+	assert.Equal(t, "RQ1 dev=00 addr=60 00 00 00 (PANEL) size=4", q.String())
+
+	c := MakeCommand(Address{1, 0}, []byte{0x01, 0x7F})
+	assert.Equal(t, "DT1 dev=00 addr=00 01 00 00 data=[01 7F]", c.String())
+}
+
+func TestDescribe(t *testing.T) {
+	q := MakeQuery(Address{PanelRegion, 0}, 4)
+	b, err := q.Sysex()
+	assert.Nil(t, err)
+	assert.Equal(t, "RQ1 dev=00 addr=60 00 00 00 (PANEL) size=4 checksum ok", Describe(b))
+
+	b[len(b)-2] ^= 0x01
+	assert.Equal(t, "RQ1 dev=00 addr=60 00 00 00 (PANEL) size=4 checksum bad", Describe(b))
+
+	r := MakeIdRequest()
+	b, err = r.Sysex()
+	assert.Nil(t, err)
+	assert.Equal(t, "ID request dev=7F", Describe(b))
+
+	assert.Equal(t, "invalid: "+ErrBadLength.Error()+" [01 02]", Describe([]byte{0x01, 0x02}))
+}
@@ -0,0 +1,55 @@
+package sysex
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+func FuzzParse(f *testing.F) {
+	gs, err := readGolden()
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, g := range gs {
+		f.Add(g.frame)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		m, err := Parse(b)
+		if m == nil {
+			assert.NotNil(t, err)
+			return
+		}
+		Describe(b)
+
+		//Whatever parses should encode to something that parses the same.
+		enc, err := m.Sysex()
+		if !ValidDeviceId(m.DeviceId) {
+			assert.Equal(t, ErrBadDeviceId, err)
+			return
+		}
+		assert.Nil(t, err)
+		m2, err := Parse(enc)
+		assert.Nil(t, err)
+		assert.Equal(t, m.String(), m2.String())
+	})
+}
+
+func FuzzMakeAddress(f *testing.F) {
+	f.Add([]byte{0x10, 0x01, 0x00, 0x00})
+	f.Add([]byte{0x60, 0x00, 0x12, 0x7F})
+	f.Add([]byte{0x80, 0x00, 0x00, 0x00})
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		a, err := MakeAddress(b)
+		if err != nil {
+			return
+		}
+
+		enc, err := a.Sysex()
+		assert.Nil(t, err)
+		assert.True(t, bytes.Equal(b, enc))
+	})
+}
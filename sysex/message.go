@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/model"
 )
 
 var (
@@ -18,6 +19,8 @@ var (
 	ErrBadModel    = errors.New("Sysex Roland message should have Katana model ID.")
 	ErrBadRolandOp = fmt.Errorf("Sysex Roland message should have Query (0x%x) or Command (0x%x) operation.", queryFlag, commandFlag)
 	ErrBadChecksum = errors.New("Sysex Roland message checksum doesn't match expected value.")
+	ErrBadLength   = errors.New("Sysex message is too short for its type.")
+	ErrBadDeviceId = fmt.Errorf("Sysex device ID should be 0x%02X-0x%02X or 0x%02X.", DevIdDefault, DevIdMax, DevIdAny)
 )
 
 //Public constants for building messages.
@@ -29,13 +32,13 @@ const (
 
 	DevIdAny     = byte(0x7F)
 	DevIdDefault = byte(0x00)
+	DevIdMax     = byte(0x1F)
 )
 
 //Private values to check and serialize things.
 var (
-	modelId      = []byte{0x00, 0x00, 0x00, 0x33}
-	familyCode   = []byte{0x33, 0x03, 0x00, 0x00}
-	firmwareV102 = []byte{0x01, 0x00, 0x00, 0x00}
+	modelId    = model.ModelId
+	familyCode = model.FamilyCode
 )
 
 const (
@@ -53,6 +56,11 @@ const (
 	//Roland sysex
 	queryFlag   = byte(0x11)
 	commandFlag = byte(0x12)
+
+	//Message lengths, including header and footer.
+	lenIdRequest  = 6
+	lenIdResponse = 15
+	lenRolandMin  = 14
 )
 
 //Calculate the checksum byte for Sysex messages.
@@ -77,7 +85,13 @@ type SysexMessage struct {
 
 //Creates a SysexMessage from a byte array.
 //Be sure to include 0xF0 and 0xF7 header and footers.
+//Any device ID and firmware are accepted, use a Parser to be more picky.
 func Parse(sysex []byte) (*SysexMessage, error) {
+	//Shortest message is an ID request.
+	if len(sysex) < lenIdRequest {
+		return nil, ErrBadLength
+	}
+
 	//Check header.
 	if sysex[0] != sysexStart {
 		return nil, ErrBadHeader
@@ -88,8 +102,7 @@ func Parse(sysex []byte) (*SysexMessage, error) {
 		return nil, ErrBadFooter
 	}
 
-	//Copy device ID.
-	//TODO: Validate device ID.
+	//Copy device ID, a Parser checks which are accepted.
 	devId := sysex[2]
 
 	//What message spec are we dealing with?
@@ -107,6 +120,10 @@ func Parse(sysex []byte) (*SysexMessage, error) {
 			return &SysexMessage{Op: OpIdRequest, DeviceId: devId}, nil
 
 		case uniIdRes:
+			if len(sysex) < lenIdResponse {
+				return nil, ErrBadLength
+			}
+
 			//Responses are matched for Katana signature, firmeware version is not validated.
 			if !matchBytes(sysex[5:10], []byte{vendorId}, familyCode) {
 				return nil, ErrBadUniIdent
@@ -122,8 +139,12 @@ func Parse(sysex []byte) (*SysexMessage, error) {
 		}
 
 	case vendorId:
+		if len(sysex) < lenRolandMin {
+			return nil, ErrBadLength
+		}
+
 		//Check the model is a Katana.
-		if !matchBytes(sysex[3:7], modelId) {
+		if !model.KnownModelId(sysex[3:7]) {
 			return nil, ErrBadModel
 		}
 
@@ -183,27 +204,60 @@ func Parse(sysex []byte) (*SysexMessage, error) {
 	}
 }
 
+//Finds the Katana variant an ID response identifies as.
+func (m *SysexMessage) Capability() (*model.Capability, error) {
+	if m.Op != OpIdResponse {
+		return nil, ErrUnknownOp
+	}
+	return model.Lookup(familyCode, m.FirmwareVer)
+}
+
+//Checks a device ID can be used in a message.
+//Amps are set to 0x00-0x1F, while DevIdAny addresses all of them.
+func ValidDeviceId(id byte) bool {
+	return id <= DevIdMax || id == DevIdAny
+}
+
 //Factory for ID request sysex message.
 func MakeIdRequest() SysexMessage {
-	return SysexMessage{Op: OpIdRequest, DeviceId: DevIdAny}
+	return MakeIdRequestFor(DevIdAny)
+}
+
+//Factory for an ID request to a single device.
+func MakeIdRequestFor(deviceId byte) SysexMessage {
+	return SysexMessage{Op: OpIdRequest, DeviceId: deviceId}
 }
 
 //Factory for a query sysex message.
 func MakeQuery(a Address, s libktn.Uint28) SysexMessage {
-	return SysexMessage{Op: OpQuery, Address: a, Size: s, DeviceId: DevIdDefault}
+	return MakeQueryFor(DevIdDefault, a, s)
+}
+
+//Factory for a query sysex message to a specific device.
+func MakeQueryFor(deviceId byte, a Address, s libktn.Uint28) SysexMessage {
+	return SysexMessage{Op: OpQuery, Address: a, Size: s, DeviceId: deviceId}
 }
 
 //Factory for a command sysex message.
 func MakeCommand(a Address, din []byte) SysexMessage {
+	return MakeCommandFor(DevIdDefault, a, din)
+}
+
+//Factory for a command sysex message to a specific device.
+func MakeCommandFor(deviceId byte, a Address, din []byte) SysexMessage {
 	//Have our own copy of the slice, associated with this message.
 	dmsg := make([]byte, len(din))
 	copy(dmsg, din)
 
-	return SysexMessage{Op: OpCommand, Address: a, Data: dmsg, DeviceId: DevIdDefault}
+	return SysexMessage{Op: OpCommand, Address: a, Data: dmsg, DeviceId: deviceId}
 }
 
 //Serializes a SysexMessage to bytes, as per Katana MIDI spec.
 func (m *SysexMessage) Sysex() ([]byte, error) {
+	if !ValidDeviceId(m.DeviceId) {
+		return nil, ErrBadDeviceId
+	}
+
 	switch m.Op {
 	case OpIdRequest:
 		return idRequest(m.DeviceId)
@@ -220,13 +274,11 @@ func (m *SysexMessage) Sysex() ([]byte, error) {
 
 //Internal serialize method.
 func idRequest(deviceId byte) ([]byte, error) {
-	//TODO: validate device ID.
 	return []byte{sysexStart, uniNonRt, deviceId, uniInfo, uniIdReq, sysexEnd}, nil
 }
 
 //Internal serialize method.
 func idResponse(deviceId byte, firmwareVer []byte) ([]byte, error) {
-	//TODO: validate device ID.
 	if firmwareVer == nil {
 		return nil, libktn.RequiredError("FirmwareVer")
 	}
@@ -251,8 +303,6 @@ func idResponse(deviceId byte, firmwareVer []byte) ([]byte, error) {
 
 //Internal serialize method.
 func query(deviceId byte, addr Address, size libktn.Uint28) ([]byte, error) {
-	//TODO: validate device ID.
-
 	a, aerr := addr.Sysex()
 	if aerr != nil {
 		return nil, aerr
@@ -279,8 +329,6 @@ func query(deviceId byte, addr Address, size libktn.Uint28) ([]byte, error) {
 
 //Internal serialize method.
 func command(deviceId byte, addr Address, data []byte) ([]byte, error) {
-	//TODO: validate device ID.
-
 	a, aerr := addr.Sysex()
 	if aerr != nil {
 		return nil, aerr
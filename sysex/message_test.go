@@ -6,6 +6,7 @@ import (
 	"github.com/stvp/assert"
 
 	libktn "github.com/katana-dev/lib-katana"
+	"github.com/katana-dev/lib-katana/model"
 )
 
 //TODO: Test Sysex methods
@@ -108,3 +109,38 @@ func TestMakeCommand(t *testing.T) {
 	m := MakeCommand(a, d)
 	assert.Equal(t, SysexMessage{Op: OpCommand, Address: a, Data: d, DeviceId: 0x00}, m)
 }
+
+func TestCapability(t *testing.T) {
+	m, e := Parse([]byte{0xF0, 0x7E, 0x00, 0x06, 0x02, 0x41, 0x33, 0x03, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0xF7})
+	assert.Nil(t, e)
+	c, e := m.Capability()
+	assert.Nil(t, e)
+	assert.Equal(t, model.KatanaV2, c)
+
+	q := MakeQuery(Address{Region: PanelRegion}, 1)
+	c, e = q.Capability()
+	assert.Equal(t, ErrUnknownOp, e)
+	assert.Nil(t, c)
+}
+
+func TestDeviceIds(t *testing.T) {
+	for id, valid := range map[byte]bool{0x00: true, 0x10: true, 0x1F: true, 0x20: false, 0x7E: false, 0x7F: true, 0x80: false} {
+		assert.Equal(t, valid, ValidDeviceId(id))
+
+		m := MakeQueryFor(id, Address{PanelRegion, 0}, 1)
+		b, err := m.Sysex()
+		if valid {
+			assert.Nil(t, err)
+			assert.Equal(t, id, b[2])
+		} else {
+			assert.Equal(t, ErrBadDeviceId, err)
+			assert.Nil(t, b)
+		}
+	}
+
+	assert.Equal(t, byte(0x05), MakeIdRequestFor(0x05).DeviceId)
+	assert.Equal(t, byte(0x05), MakeCommandFor(0x05, Address{PanelRegion, 0}, nil).DeviceId)
+
+	_, err := NewParser(StrictOptions).Parse([]byte{0xF0, 0x7E, 0x20, 0x06, 0x01, 0xF7})
+	assert.Equal(t, ErrBadDeviceId, err)
+}
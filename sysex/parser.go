@@ -0,0 +1,197 @@
+package sysex
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	ErrDeviceId = errors.New("Sysex message device ID is not accepted.")
+	ErrFirmware = errors.New("Sysex ID response firmware version is not accepted.")
+)
+
+//What to do with Roland messages that fail their checksum.
+type ChecksumPolicy int
+
+const (
+	//Return the message along with ErrBadChecksum, like Parse does.
+	ChecksumWarn ChecksumPolicy = iota
+	//Return only ErrBadChecksum.
+	ChecksumReject
+	//Return the message as if nothing was wrong.
+	ChecksumIgnore
+)
+
+//Controls which messages a Parser accepts.
+//The zero value accepts the same messages as Parse.
+type ParseOptions struct {
+	//Strict parsing rejects frames with trailing bytes, empty commands, invalid device IDs
+	//and ID responses of Katana variants that aren't known.
+	Strict bool
+
+	//Accepted device IDs, or nil to accept any.
+	DeviceIds []byte
+
+	Checksum ChecksumPolicy
+
+	//Accepted firmware versions of ID responses, inclusive. Nil for no limit.
+	FirmwareMin, FirmwareMax []byte
+}
+
+var (
+	//For reading files, where anything unexpected is a problem with the file.
+	StrictOptions = ParseOptions{Strict: true, Checksum: ChecksumReject}
+
+	//For live MIDI traffic, where keeping up matters more than a bad checksum.
+	LenientOptions = ParseOptions{Checksum: ChecksumIgnore}
+)
+
+//Parses messages according to a set of ParseOptions.
+type Parser struct {
+	Options ParseOptions
+}
+
+func NewParser(o ParseOptions) *Parser {
+	return &Parser{Options: o}
+}
+
+//Creates a SysexMessage from a byte array, like Parse.
+//With ChecksumWarn a message may be returned along with ErrBadChecksum,
+//otherwise a message is only returned without error.
+func (p *Parser) Parse(sysex []byte) (*SysexMessage, error) {
+	m, err := Parse(sysex)
+	if m == nil {
+		return nil, err
+	}
+
+	o := &p.Options
+	if err == ErrBadChecksum {
+		switch o.Checksum {
+		case ChecksumReject:
+			return nil, err
+		case ChecksumIgnore:
+			err = nil
+		}
+	}
+
+	if o.Strict {
+		if serr := strictCheck(sysex, m); serr != nil {
+			return nil, serr
+		}
+	}
+
+	if o.DeviceIds != nil && bytes.IndexByte(o.DeviceIds, m.DeviceId) < 0 {
+		return nil, ErrDeviceId
+	}
+
+	if m.Op == OpIdResponse {
+		if o.FirmwareMin != nil && bytes.Compare(m.FirmwareVer, o.FirmwareMin) < 0 {
+			return nil, ErrFirmware
+		}
+		if o.FirmwareMax != nil && bytes.Compare(m.FirmwareVer, o.FirmwareMax) > 0 {
+			return nil, ErrFirmware
+		}
+	}
+
+	return m, err
+}
+
+//Checks what Parse lets slide.
+func strictCheck(sysex []byte, m *SysexMessage) error {
+	if !ValidDeviceId(m.DeviceId) {
+		return ErrBadDeviceId
+	}
+
+	switch m.Op {
+	case OpIdRequest:
+		if len(sysex) != lenIdRequest {
+			return ErrBadLength
+		}
+
+	case OpIdResponse:
+		if len(sysex) != lenIdResponse {
+			return ErrBadLength
+		}
+		if _, err := m.Capability(); err != nil {
+			return ErrFirmware
+		}
+
+	case OpCommand:
+		if len(m.Data) == 0 {
+			return ErrBadLength
+		}
+	}
+	return nil
+}
+
+//Creates a Reader that parses messages with this parser.
+func (p *Parser) NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r), p: p}
+}
+
+//A frame that ReadAll skipped, or read along with a checksum warning.
+type FrameError struct {
+	//Position of the frame in the stream, counting from 0.
+	Frame int
+	Err   error
+}
+
+//The problems ReadAll ran into, in stream order.
+type ReadErrors []FrameError
+
+func (e ReadErrors) Error() string {
+	s := make([]string, len(e))
+	for i, fe := range e {
+		s[i] = fmt.Sprintf("frame %d: %s", fe.Frame, fe.Err)
+	}
+	return strings.Join(s, "; ")
+}
+
+//Reads all messages in a stream, such as a .syx file.
+//Frames the parser doesn't accept are skipped, reading continues with the next one.
+//When any were, the messages that were read are returned along with ReadErrors.
+func (p *Parser) ReadAll(r io.Reader) ([]*SysexMessage, error) {
+	var (
+		msgs []*SysexMessage
+		errs ReadErrors
+	)
+	sr := p.NewReader(r)
+	for i := 0; ; i++ {
+		f, err := sr.ReadFrame()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return msgs, err
+		}
+
+		m, err := p.Parse(f)
+		if err != nil {
+			errs = append(errs, FrameError{Frame: i, Err: err})
+		}
+		if m != nil {
+			msgs = append(msgs, m)
+		}
+	}
+
+	if errs != nil {
+		return msgs, errs
+	}
+	return msgs, nil
+}
+
+//Reads all messages in a .syx file.
+func (p *Parser) ReadFile(path string) ([]*SysexMessage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return p.ReadAll(f)
+}
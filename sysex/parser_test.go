@@ -0,0 +1,114 @@
+package sysex
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stvp/assert"
+)
+
+func frame(t *testing.T, m SysexMessage) []byte {
+	b, err := m.Sysex()
+	assert.Nil(t, err)
+	return b
+}
+
+func TestParserChecksum(t *testing.T) {
+	b := frame(t, MakeCommand(Address{PanelRegion, 16}, []byte{0x01}))
+	b[len(b)-2] ^= 0x01
+
+	m, err := NewParser(ParseOptions{}).Parse(b)
+	assert.NotNil(t, m)
+	assert.Equal(t, ErrBadChecksum, err)
+
+	m, err = NewParser(ParseOptions{Checksum: ChecksumReject}).Parse(b)
+	assert.Nil(t, m)
+	assert.Equal(t, ErrBadChecksum, err)
+
+	m, err = NewParser(LenientOptions).Parse(b)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x01}, m.Data)
+}
+
+func TestParserStrict(t *testing.T) {
+	strict := NewParser(StrictOptions)
+	lenient := NewParser(LenientOptions)
+
+	long := []byte{0xF0, 0x7E, 0x7F, 0x06, 0x01, 0x00, 0xF7}
+	_, err := lenient.Parse(long)
+	assert.Nil(t, err)
+	_, err = strict.Parse(long)
+	assert.Equal(t, ErrBadLength, err)
+
+	empty := frame(t, MakeCommand(Address{PanelRegion, 16}, nil))
+	_, err = lenient.Parse(empty)
+	assert.Nil(t, err)
+	_, err = strict.Parse(empty)
+	assert.Equal(t, ErrBadLength, err)
+
+	unknown := frame(t, SysexMessage{Op: OpIdResponse, FirmwareVer: []byte{0x09, 0x00, 0x00, 0x00}})
+	_, err = lenient.Parse(unknown)
+	assert.Nil(t, err)
+	_, err = strict.Parse(unknown)
+	assert.Equal(t, ErrFirmware, err)
+
+	m, err := strict.Parse(frame(t, MakeQuery(Address{CH1Region, 0}, 16)))
+	assert.Nil(t, err)
+	assert.Equal(t, OpQuery, int(m.Op))
+}
+
+func TestParserDeviceIds(t *testing.T) {
+	p := NewParser(ParseOptions{DeviceIds: []byte{0x00, 0x7F}})
+
+	_, err := p.Parse(frame(t, MakeIdRequest()))
+	assert.Nil(t, err)
+
+	m, err := p.Parse([]byte{0xF0, 0x7E, 0x03, 0x06, 0x01, 0xF7})
+	assert.Nil(t, m)
+	assert.Equal(t, ErrDeviceId, err)
+}
+
+func TestParserFirmware(t *testing.T) {
+	p := NewParser(ParseOptions{FirmwareMin: []byte{0x02, 0x00, 0x00, 0x00}, FirmwareMax: []byte{0x03, 0x7F, 0x7F, 0x7F}})
+
+	for fw, exp := range map[byte]error{0x01: ErrFirmware, 0x02: nil, 0x03: nil, 0x04: ErrFirmware} {
+		_, err := p.Parse(frame(t, SysexMessage{Op: OpIdResponse, FirmwareVer: []byte{fw, 0x00, 0x00, 0x00}}))
+		assert.Equal(t, exp, err)
+	}
+}
+
+func TestParserReadAll(t *testing.T) {
+	good := frame(t, MakeQuery(Address{CH1Region, 0}, 16))
+	bad := frame(t, MakeCommand(Address{PanelRegion, 16}, []byte{0x01}))
+	bad[len(bad)-2] ^= 0x01
+	stream := append(append([]byte{}, good...), bad...)
+
+	msgs, err := NewParser(LenientOptions).ReadAll(bytes.NewReader(stream))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(msgs))
+
+	msgs, err = NewParser(StrictOptions).ReadAll(bytes.NewReader(stream))
+	assert.Equal(t, ReadErrors{{Frame: 1, Err: ErrBadChecksum}}, err)
+	assert.Equal(t, 1, len(msgs))
+}
+
+func TestReadAllSkips(t *testing.T) {
+	good := frame(t, MakeQuery(Address{CH1Region, 0}, 16))
+	bad := frame(t, MakeCommand(Address{PanelRegion, 16}, []byte{0x01}))
+	bad[len(bad)-2] ^= 0x01
+	other := []byte{0xF0, 0x43, 0x10, 0x4C, 0x00, 0x00, 0x7E, 0x00, 0xF7}
+
+	var stream []byte
+	for _, f := range [][]byte{other, good, bad, good} {
+		stream = append(stream, f...)
+	}
+
+	//Reading goes on after a frame of another device, a bad checksum is kept as a warning.
+	msgs, err := ReadAll(bytes.NewReader(stream))
+	errs, ok := err.(ReadErrors)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, 0, errs[0].Frame)
+	assert.Equal(t, FrameError{Frame: 2, Err: ErrBadChecksum}, errs[1])
+	assert.Equal(t, 3, len(msgs))
+}
@@ -0,0 +1,143 @@
+package sysex
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/katana-dev/lib-katana/midi"
+)
+
+const (
+	statusMask   = byte(0x80)
+	realtimeMask = byte(0xF8)
+)
+
+//Reads sysex frames from a MIDI byte stream.
+//Bytes outside of frames and interleaved realtime messages are skipped.
+//Frames interrupted by another status byte are dropped.
+//ReadEvent also returns the channel voice messages in between.
+type Reader struct {
+	r *bufio.Reader
+	p *Parser
+
+	//Status byte of running status, 0 when there is none.
+	running byte
+}
+
+//Parses like the Parse function.
+var defaultParser = &Parser{}
+
+//Creates a new Reader for a byte stream.
+func NewReader(r io.Reader) *Reader {
+	return defaultParser.NewReader(r)
+}
+
+//Either a sysex frame or a channel voice message.
+type Event struct {
+	Frame []byte
+	Voice *midi.Message
+}
+
+//Reads the next sysex frame or channel voice message the midi package supports.
+//Other voice messages, such as notes, are skipped.
+//Returns io.EOF when no more complete events are available.
+func (r *Reader) ReadEvent() (Event, error) {
+	var frame, voice []byte
+	for {
+		b, err := r.r.ReadByte()
+		if err != nil {
+			return Event{}, err
+		}
+
+		switch {
+		case b == sysexStart:
+			//Start over, even if we were in the middle of a frame.
+			frame = []byte{b}
+			r.running = 0
+
+		case b&realtimeMask == realtimeMask:
+			//Realtime messages may appear anywhere, ignore them.
+
+		case b == sysexEnd && frame != nil:
+			return Event{Frame: append(frame, b)}, nil
+
+		case b&statusMask != 0:
+			//Any other status byte interrupts the frame.
+			frame = nil
+			voice = nil
+			r.running = 0
+			if midi.DataLen(b) > 0 {
+				r.running = b
+			}
+
+		case frame != nil:
+			frame = append(frame, b)
+
+		case r.running != 0:
+			if voice == nil {
+				voice = []byte{r.running}
+			}
+			voice = append(voice, b)
+
+			if len(voice) == midi.DataLen(r.running)+1 {
+				m, err := midi.Parse(voice)
+				voice = nil
+				if err == nil {
+					return Event{Voice: m}, nil
+				}
+			}
+
+		default:
+			//Not in a frame or message, skip everything else.
+		}
+	}
+}
+
+//Reads the next complete frame, including the 0xF0 and 0xF7 header and footer.
+//Returns io.EOF when no more complete frames are available.
+func (r *Reader) ReadFrame() ([]byte, error) {
+	for {
+		e, err := r.ReadEvent()
+		if err != nil {
+			return nil, err
+		}
+		if e.Frame != nil {
+			return e.Frame, nil
+		}
+	}
+}
+
+//Reads and parses the next frame with the reader's Parser.
+//Just like Parse, a message may be returned along with ErrBadChecksum.
+func (r *Reader) ReadMessage() (*SysexMessage, error) {
+	f, err := r.ReadFrame()
+	if err != nil {
+		return nil, err
+	}
+	return r.p.Parse(f)
+}
+
+//Reads all messages in a stream, such as a .syx file.
+//Frames that aren't Katana messages are skipped, see Parser.ReadAll.
+func ReadAll(r io.Reader) ([]*SysexMessage, error) {
+	return defaultParser.ReadAll(r)
+}
+
+//Reads all messages in a .syx file.
+func ReadFile(path string) ([]*SysexMessage, error) {
+	return defaultParser.ReadFile(path)
+}
+
+//Serializes messages back to back, as used in .syx files.
+func WriteAll(w io.Writer, msgs []SysexMessage) error {
+	for i := range msgs {
+		b, err := msgs[i].Sysex()
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
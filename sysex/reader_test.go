@@ -0,0 +1,108 @@
+package sysex
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stvp/assert"
+
+	"github.com/katana-dev/lib-katana/midi"
+)
+
+func TestReadFrame(t *testing.T) {
+	var (
+		idReq = []byte{0xF0, 0x7E, 0x7F, 0x06, 0x01, 0xF7}
+		query = []byte{0xF0, 0x41, 0x00, 0x00, 0x00, 0x00, 0x33, 0x11, 0x60, 0x00, 0x00, 0x53, 0x00, 0x00, 0x00, 0x01, 0x4C, 0xF7}
+	)
+
+	//Garbage, a frame broken by a status byte, realtime clock inside a frame.
+	stream := []byte{0x01, 0x02}
+	stream = append(stream, 0xF0, 0x41, 0x00, 0x90, 0x40, 0x7F)
+	stream = append(stream, idReq[:3]...)
+	stream = append(stream, 0xF8)
+	stream = append(stream, idReq[3:]...)
+	stream = append(stream, query...)
+	stream = append(stream, 0xF0, 0x41)
+
+	r := NewReader(bytes.NewReader(stream))
+
+	f, e := r.ReadFrame()
+	assert.Nil(t, e)
+	assert.Equal(t, idReq, f)
+
+	f, e = r.ReadFrame()
+	assert.Nil(t, e)
+	assert.Equal(t, query, f)
+
+	//Incomplete trailing frame.
+	f, e = r.ReadFrame()
+	assert.Equal(t, io.EOF, e)
+	assert.Nil(t, f)
+}
+
+func TestReadEvent(t *testing.T) {
+	idReq := []byte{0xF0, 0x7E, 0x7F, 0x06, 0x01, 0xF7}
+
+	//Program change, note on, control changes with running status and a clock,
+	//a sysex frame and a control change that ends early.
+	stream := []byte{0xC0, 0x02}
+	stream = append(stream, 0x90, 0x40, 0x7F)
+	stream = append(stream, 0xB1, 0x10, 0x7F, 0x11, 0xF8, 0x00)
+	stream = append(stream, idReq...)
+	stream = append(stream, 0xB0, 0x12)
+
+	r := NewReader(bytes.NewReader(stream))
+	exp := []Event{
+		{Voice: &midi.Message{Type: midi.TypeProgramChange, Data1: 2}},
+		{Voice: &midi.Message{Type: midi.TypeControlChange, Channel: 1, Data1: 0x10, Data2: 0x7F}},
+		{Voice: &midi.Message{Type: midi.TypeControlChange, Channel: 1, Data1: 0x11, Data2: 0x00}},
+		{Frame: idReq},
+	}
+	for _, e := range exp {
+		ev, err := r.ReadEvent()
+		assert.Nil(t, err)
+		assert.Equal(t, e, ev)
+	}
+
+	_, err := r.ReadEvent()
+	assert.Equal(t, io.EOF, err)
+
+	//Frames only skip the voice messages.
+	f, err := NewReader(bytes.NewReader(stream)).ReadFrame()
+	assert.Nil(t, err)
+	assert.Equal(t, idReq, f)
+}
+
+func TestReadWriteAll(t *testing.T) {
+	msgs := []SysexMessage{
+		MakeCommand(Address{Region: CH1Region, Offset: 0}, []byte{0x4B, 0x41, 0x54, 0x41}),
+		MakeQuery(Address{Region: PanelRegion, Offset: 0x53}, 1),
+	}
+
+	b := bytes.Buffer{}
+	assert.Nil(t, WriteAll(&b, msgs))
+
+	r, e := ReadAll(&b)
+	assert.Nil(t, e)
+	assert.Equal(t, 2, len(r))
+	for i := range msgs {
+		assert.Equal(t, msgs[i], *r[i])
+	}
+}
+
+func TestParseShort(t *testing.T) {
+	var (
+		short = [][]byte{
+			[]byte{0xF0, 0xF7},
+			[]byte{0xF0, 0x7E, 0x7F, 0x06, 0x02, 0x41, 0xF7},
+			[]byte{0xF0, 0x41, 0x00, 0x00, 0x00, 0x00, 0x33, 0x12, 0xF7},
+		}
+	)
+
+	for _, in := range short {
+		m, e := Parse(in)
+		assert.Nil(t, m)
+		assert.Equal(t, ErrBadLength, e)
+	}
+}